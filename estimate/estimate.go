@@ -0,0 +1,190 @@
+// Package estimate calculates the cost of UpCloud servers from the published price list.
+package estimate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rsclarke/go-upcloud/upcloud"
+)
+
+// HoursPerMonth is the number of hours billed in a month, usage beyond it is free
+const HoursPerMonth = 672
+
+// Storage tiers
+const (
+	StorageTierHDD     = "hdd"
+	StorageTierMaxIOPS = "maxiops"
+)
+
+// Storage represents a storage device attached to a server
+type Storage struct {
+	Size int    // GiB
+	Tier string // hdd/maxiops, defaults to maxiops
+}
+
+// ServerSpec represents the resources of a server to estimate.
+// Either Plan or a custom configuration of Cores and Memory must be given.
+// The storage and outbound traffic included in a plan are deducted before pricing the remainder.
+type ServerSpec struct {
+	Zone       string
+	Plan       string // e.g. "1xCPU-1GB"
+	Cores      int
+	Memory     int // MiB
+	Storages   []Storage
+	IPv4       int // Number of public IPv4 addresses
+	IPv6       int // Number of public IPv6 addresses
+	Firewall   bool
+	TrafficOut int // Expected outbound public traffic in GiB per month
+}
+
+// LineItem represents the cost of a single resource.
+// Hourly and Monthly are in the account currency, not cents as in the price list.
+type LineItem struct {
+	Description string
+	Quantity    float64
+	Hourly      float64
+	Monthly     float64
+}
+
+// Estimate represents the cost of a server broken down into line items
+type Estimate struct {
+	Zone    string
+	Items   []LineItem
+	Hourly  float64
+	Monthly float64
+}
+
+func (e *Estimate) add(item LineItem) {
+	e.Items = append(e.Items, item)
+	e.Hourly += item.Hourly
+	e.Monthly += item.Monthly
+}
+
+// Estimator prices server specifications using a price list and the available plans
+type Estimator struct {
+	zones map[string]upcloud.ZonePricing
+	plans map[string]upcloud.Plan
+}
+
+// New returns an Estimator for the given prices and plans.
+func New(prices *upcloud.PriceList, plans *upcloud.PlanList) *Estimator {
+	e := &Estimator{
+		zones: make(map[string]upcloud.ZonePricing),
+		plans: make(map[string]upcloud.Plan),
+	}
+	if prices != nil {
+		for _, z := range prices.ZonePrice {
+			e.zones[z.Name] = z
+		}
+	}
+	if plans != nil {
+		for _, p := range plans.Plans {
+			e.plans[p.Name] = p
+		}
+	}
+	return e
+}
+
+// NewFromClient returns an Estimator using the current prices and plans from the UpCloud API.
+func NewFromClient(ctx context.Context, c *upcloud.Client) (*Estimator, error) {
+	prices, _, err := c.Pricing.ListPrices(ctx)
+	if err != nil {
+		return nil, err
+	}
+	plans, _, err := c.Plans.ListAvailablePlans(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return New(prices, plans), nil
+}
+
+// hourly returns a line item for quantity units of an hourly price
+func hourly(description string, quantity float64, price upcloud.UnitPrice) LineItem {
+	item := LineItem{Description: description, Quantity: quantity}
+	if price.Amount > 0 {
+		item.Hourly = quantity / price.Amount * price.Price / 100
+		item.Monthly = item.Hourly * HoursPerMonth
+	}
+	return item
+}
+
+// Estimate returns the hourly and monthly cost of the server described by spec.
+func (e *Estimator) Estimate(spec *ServerSpec) (*Estimate, error) {
+	zone, ok := e.zones[spec.Zone]
+	if !ok {
+		return nil, fmt.Errorf("no prices for zone %q", spec.Zone)
+	}
+
+	est := &Estimate{Zone: spec.Zone}
+	var includedStorage, includedTraffic int
+	var includedTier string
+
+	switch {
+	case spec.Plan != "" && (spec.Cores != 0 || spec.Memory != 0):
+		return nil, fmt.Errorf("plan %q cannot be combined with custom cores or memory", spec.Plan)
+	case spec.Plan != "":
+		plan, ok := e.plans[spec.Plan]
+		if !ok {
+			return nil, fmt.Errorf("plan %q is not available", spec.Plan)
+		}
+		price, ok := zone.PlanPrice(spec.Plan)
+		if !ok {
+			return nil, fmt.Errorf("no price for plan %q in zone %q", spec.Plan, spec.Zone)
+		}
+		est.add(hourly("Plan "+spec.Plan, 1, price))
+		includedStorage, includedTier, includedTraffic = plan.StorageSize, plan.StorageTier, plan.PublicTrafficOut
+	case spec.Cores > 0 && spec.Memory > 0:
+		est.add(hourly("CPU cores", float64(spec.Cores), zone.ServerCore))
+		est.add(hourly("Memory (MiB)", float64(spec.Memory), zone.ServerMemory))
+	default:
+		return nil, fmt.Errorf("either a plan or custom cores and memory must be given")
+	}
+
+	for i, s := range spec.Storages {
+		tier := s.Tier
+		if tier == "" {
+			tier = StorageTierMaxIOPS
+		}
+		var price upcloud.UnitPrice
+		switch tier {
+		case StorageTierHDD:
+			price = zone.StorageHDD
+		case StorageTierMaxIOPS:
+			price = zone.StorageMaxIOPS
+		default:
+			return nil, fmt.Errorf("storage %d tier %q is invalid", i, s.Tier)
+		}
+		size := s.Size
+		if tier == includedTier && includedStorage > 0 {
+			free := includedStorage
+			if free > size {
+				free = size
+			}
+			size -= free
+			includedStorage -= free
+		}
+		if size > 0 {
+			est.add(hourly(fmt.Sprintf("Storage %d %v (GiB)", i, tier), float64(size), price))
+		}
+	}
+
+	if spec.IPv4 > 0 {
+		est.add(hourly("Public IPv4 addresses", float64(spec.IPv4), zone.IPv4Address))
+	}
+	if spec.IPv6 > 0 {
+		est.add(hourly("Public IPv6 addresses", float64(spec.IPv6), zone.IPv6Address))
+	}
+	if spec.Firewall {
+		est.add(hourly("Firewall", 1, zone.Firewall))
+	}
+
+	// Traffic is priced per GiB transferred rather than per hour
+	if traffic := spec.TrafficOut - includedTraffic; traffic > 0 && zone.PublicIpv4BandwidthOut.Amount > 0 {
+		price := zone.PublicIpv4BandwidthOut
+		m := float64(traffic) / price.Amount * price.Price / 100
+		est.add(LineItem{Description: "Outbound traffic (GiB)", Quantity: float64(traffic), Hourly: m / HoursPerMonth, Monthly: m})
+	}
+
+	return est, nil
+}
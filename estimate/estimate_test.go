@@ -0,0 +1,142 @@
+package estimate
+
+import (
+	"math"
+	"testing"
+
+	"github.com/rsclarke/go-upcloud/upcloud"
+)
+
+// Prices are in cents per Amount units per hour, traffic per Amount GiB
+var testPrices = &upcloud.PriceList{ZonePrice: []upcloud.ZonePricing{{
+	Name:                   "fi-hel1",
+	ServerCore:             upcloud.UnitPrice{Amount: 1, Price: 2},
+	ServerMemory:           upcloud.UnitPrice{Amount: 256, Price: 0.5},
+	StorageMaxIOPS:         upcloud.UnitPrice{Amount: 1, Price: 0.05},
+	StorageHDD:             upcloud.UnitPrice{Amount: 1, Price: 0.02},
+	IPv4Address:            upcloud.UnitPrice{Amount: 1, Price: 0.5},
+	IPv6Address:            upcloud.UnitPrice{Amount: 1, Price: 0},
+	Firewall:               upcloud.UnitPrice{Amount: 1, Price: 0.25},
+	PublicIpv4BandwidthOut: upcloud.UnitPrice{Amount: 1, Price: 1},
+	ServerPlans: map[string]upcloud.UnitPrice{
+		"1xCPU-1GB": {Amount: 1, Price: 1.5},
+	},
+}}}
+
+var testPlans = &upcloud.PlanList{Plans: []upcloud.Plan{
+	{Name: "1xCPU-1GB", CoreNumber: 1, MemoryAmount: 1024, StorageSize: 25, StorageTier: StorageTierMaxIOPS, PublicTrafficOut: 1024},
+	{Name: "2xCPU-4GB", CoreNumber: 2, MemoryAmount: 4096, StorageSize: 80, StorageTier: StorageTierMaxIOPS, PublicTrafficOut: 4096},
+}}
+
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestEstimatePlan(t *testing.T) {
+	e := New(testPrices, testPlans)
+	got, err := e.Estimate(&ServerSpec{
+		Zone: "fi-hel1",
+		Plan: "1xCPU-1GB",
+		Storages: []Storage{
+			{Size: 30, Tier: StorageTierMaxIOPS}, // 25 GiB included in the plan
+			{Size: 20, Tier: StorageTierHDD},     // Different tier, fully charged
+			{Size: 10},                           // Defaults to maxiops, included storage already used
+		},
+		IPv4:       1,
+		Firewall:   true,
+		TrafficOut: 1524, // 1024 GiB included in the plan
+	})
+	if err != nil {
+		t.Fatalf("Estimate returned error: %v", err)
+	}
+
+	want := []LineItem{
+		{Description: "Plan 1xCPU-1GB", Quantity: 1, Hourly: 0.015, Monthly: 10.08},
+		{Description: "Storage 0 maxiops (GiB)", Quantity: 5, Hourly: 0.0025, Monthly: 1.68},
+		{Description: "Storage 1 hdd (GiB)", Quantity: 20, Hourly: 0.004, Monthly: 2.688},
+		{Description: "Storage 2 maxiops (GiB)", Quantity: 10, Hourly: 0.005, Monthly: 3.36},
+		{Description: "Public IPv4 addresses", Quantity: 1, Hourly: 0.005, Monthly: 3.36},
+		{Description: "Firewall", Quantity: 1, Hourly: 0.0025, Monthly: 1.68},
+		{Description: "Outbound traffic (GiB)", Quantity: 500, Hourly: 5.0 / HoursPerMonth, Monthly: 5},
+	}
+	checkItems(t, got.Items, want)
+
+	if wantHourly := 0.034 + 5.0/HoursPerMonth; !almostEqual(got.Hourly, wantHourly) {
+		t.Errorf("Hourly = %v, want %v", got.Hourly, wantHourly)
+	}
+	if !almostEqual(got.Monthly, 27.848) {
+		t.Errorf("Monthly = %v, want %v", got.Monthly, 27.848)
+	}
+}
+
+func TestEstimateCustom(t *testing.T) {
+	e := New(testPrices, testPlans)
+	got, err := e.Estimate(&ServerSpec{
+		Zone:       "fi-hel1",
+		Cores:      2,
+		Memory:     2048,
+		TrafficOut: 100, // Nothing included without a plan
+	})
+	if err != nil {
+		t.Fatalf("Estimate returned error: %v", err)
+	}
+
+	want := []LineItem{
+		{Description: "CPU cores", Quantity: 2, Hourly: 0.04, Monthly: 26.88},
+		{Description: "Memory (MiB)", Quantity: 2048, Hourly: 0.04, Monthly: 26.88},
+		{Description: "Outbound traffic (GiB)", Quantity: 100, Hourly: 1.0 / HoursPerMonth, Monthly: 1},
+	}
+	checkItems(t, got.Items, want)
+
+	if wantHourly := 0.08 + 1.0/HoursPerMonth; !almostEqual(got.Hourly, wantHourly) {
+		t.Errorf("Hourly = %v, want %v", got.Hourly, wantHourly)
+	}
+	if !almostEqual(got.Monthly, 54.76) {
+		t.Errorf("Monthly = %v, want %v", got.Monthly, 54.76)
+	}
+}
+
+func TestEstimateIncludedTrafficIsFree(t *testing.T) {
+	e := New(testPrices, testPlans)
+	got, err := e.Estimate(&ServerSpec{Zone: "fi-hel1", Plan: "1xCPU-1GB", Storages: []Storage{{Size: 25}}, TrafficOut: 1024})
+	if err != nil {
+		t.Fatalf("Estimate returned error: %v", err)
+	}
+
+	checkItems(t, got.Items, []LineItem{{Description: "Plan 1xCPU-1GB", Quantity: 1, Hourly: 0.015, Monthly: 10.08}})
+}
+
+func TestEstimateErrors(t *testing.T) {
+	e := New(testPrices, testPlans)
+	tests := []struct {
+		name string
+		spec ServerSpec
+	}{
+		{"unknown zone", ServerSpec{Zone: "xx-xxx1", Plan: "1xCPU-1GB"}},
+		{"plan with cores", ServerSpec{Zone: "fi-hel1", Plan: "1xCPU-1GB", Cores: 2}},
+		{"plan with memory", ServerSpec{Zone: "fi-hel1", Plan: "1xCPU-1GB", Memory: 2048}},
+		{"unknown plan", ServerSpec{Zone: "fi-hel1", Plan: "64xCPU-1TB"}},
+		{"plan without price", ServerSpec{Zone: "fi-hel1", Plan: "2xCPU-4GB"}},
+		{"no plan or custom", ServerSpec{Zone: "fi-hel1", Cores: 2}},
+		{"invalid tier", ServerSpec{Zone: "fi-hel1", Cores: 1, Memory: 1024, Storages: []Storage{{Size: 10, Tier: "ssd"}}}},
+	}
+	for _, tt := range tests {
+		if _, err := e.Estimate(&tt.spec); err == nil {
+			t.Errorf("%v: Estimate returned no error", tt.name)
+		}
+	}
+}
+
+func checkItems(t *testing.T, got, want []LineItem) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("got %d line items %+v, want %d", len(got), got, len(want))
+	}
+	for i := range want {
+		g, w := got[i], want[i]
+		if g.Description != w.Description || !almostEqual(g.Quantity, w.Quantity) ||
+			!almostEqual(g.Hourly, w.Hourly) || !almostEqual(g.Monthly, w.Monthly) {
+			t.Errorf("item %d = %+v, want %+v", i, g, w)
+		}
+	}
+}
@@ -4,19 +4,22 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/rsclarke/go-upcloud/upcloud"
 )
 
 func main() {
 
-	tp := upcloud.BasicAuthTransport{
-		Username: os.Getenv("UPCLOUD_USERNAME"),
-		Password: os.Getenv("UPCLOUD_PASSWORD"),
+	// Credentials are read from UPCLOUD_USERNAME/UPCLOUD_PASSWORD or a profile in ~/.upcloud/config
+	client, err := upcloud.NewClientFromEnv()
+	if err != nil {
+		fmt.Printf("error: %v\n", err)
+		os.Exit(1)
 	}
-
-	client := upcloud.NewClient(tp.Client())
-	ctx := context.Background()
+	// Bound each call with a context deadline rather than a client wide timeout
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
 
 	account, _, err := client.Accounts.GetAccountDetails(ctx, "someUser")
 	if err != nil {
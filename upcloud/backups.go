@@ -0,0 +1,123 @@
+package upcloud
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// BackupInterval represents how often a backup rule creates a backup
+type BackupInterval string
+
+// Backup intervals, either every day or once a week on the given weekday
+const (
+	BackupIntervalDaily     BackupInterval = "daily"
+	BackupIntervalMonday    BackupInterval = "mon"
+	BackupIntervalTuesday   BackupInterval = "tue"
+	BackupIntervalWednesday BackupInterval = "wed"
+	BackupIntervalThursday  BackupInterval = "thu"
+	BackupIntervalFriday    BackupInterval = "fri"
+	BackupIntervalSaturday  BackupInterval = "sat"
+	BackupIntervalSunday    BackupInterval = "sun"
+)
+
+const (
+	backupRetentionMin = 1
+	backupRetentionMax = 1095
+)
+
+// BackupRule represents the automatic backup schedule of a storage
+type BackupRule struct {
+	Interval  BackupInterval `json:"interval"`
+	Time      string         `json:"time"`             //hhmm in UTC
+	Retention int            `json:"retention,string"` //Days, 1-1095
+}
+
+// UnmarshalJSON decodes a backup rule, the API returns an empty string when no rule is set.
+func (r *BackupRule) UnmarshalJSON(data []byte) error {
+	if string(data) == `""` {
+		*r = BackupRule{}
+		return nil
+	}
+
+	type backupRule BackupRule
+	return json.Unmarshal(data, (*backupRule)(r))
+}
+
+// Validate reports whether the backup rule will be accepted by the API.
+func (r *BackupRule) Validate() error {
+	switch r.Interval {
+	case BackupIntervalDaily, BackupIntervalMonday, BackupIntervalTuesday, BackupIntervalWednesday,
+		BackupIntervalThursday, BackupIntervalFriday, BackupIntervalSaturday, BackupIntervalSunday:
+	default:
+		return fmt.Errorf("backup rule interval %q is invalid", r.Interval)
+	}
+
+	if _, err := time.Parse("1504", r.Time); err != nil {
+		return fmt.Errorf("backup rule time %q must be in hhmm format", r.Time)
+	}
+
+	if r.Retention < backupRetentionMin || r.Retention > backupRetentionMax {
+		return fmt.Errorf("backup rule retention %d must be between %d and %d days", r.Retention, backupRetentionMin, backupRetentionMax)
+	}
+	return nil
+}
+
+// CreateBackupRequest represents the properties of a new backup
+type CreateBackupRequest struct {
+	Title string `json:"title"`
+}
+
+type createBackupRequestWrapper struct {
+	Storage *CreateBackupRequest `json:"storage"`
+}
+
+// CreateBackup creates a point in time backup of the storage with the given uuid.
+// https://developers.upcloud.com/1.3/9-storages/#create-backup
+func (s *StorageService) CreateBackup(ctx context.Context, uuid string, r *CreateBackupRequest) (*StorageDetails, *http.Response, error) {
+	u := fmt.Sprintf("storage/%v/backup", uuid)
+	req, err := s.client.NewRequest("POST", u, &createBackupRequestWrapper{Storage: r})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	backup := new(StorageDetails)
+	resp, err := s.client.Do(ctx, req, &StorageDetailsResponse{Storage: backup})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return backup, resp, nil
+}
+
+// RestoreBackup restores the origin storage with data from the backup with the given uuid.
+// The origin storage must not be attached to a started server.
+// https://developers.upcloud.com/1.3/9-storages/#restore-backup
+func (s *StorageService) RestoreBackup(ctx context.Context, backupUUID string) (*http.Response, error) {
+	u := fmt.Sprintf("storage/%v/restore", backupUUID)
+	req, err := s.client.NewRequest("POST", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListBackups returns the backups created from the storage with the given uuid.
+func (s *StorageService) ListBackups(ctx context.Context, uuid string) (*StorageList, *http.Response, error) {
+	storageList, resp, err := s.ListStorages(ctx, StorageTypeBackup)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	backups := &StorageList{Storages: []Storage{}}
+	for _, b := range storageList.Storages {
+		if b.Origin == uuid {
+			backups.Storages = append(backups.Storages, b)
+		}
+	}
+
+	return backups, resp, nil
+}
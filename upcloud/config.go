@@ -0,0 +1,245 @@
+package upcloud
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Environment variables read by NewClientFromEnv
+const (
+	EnvUsername   = "UPCLOUD_USERNAME"
+	EnvPassword   = "UPCLOUD_PASSWORD"
+	EnvBaseURL    = "UPCLOUD_BASE_URL"
+	EnvProfile    = "UPCLOUD_PROFILE"
+	EnvConfigFile = "UPCLOUD_CONFIG_FILE"
+)
+
+const (
+	defaultProfile    = "default"
+	defaultConfigFile = ".upcloud/config" // Relative to the home directory
+)
+
+type clientOptions struct {
+	baseURL     string
+	username    string
+	password    string
+	httpClient  *http.Client
+	userAgent   *string
+	timeout     time.Duration
+	retryPolicy *RetryPolicy
+	limiter     Limiter
+	maxInFlight int
+}
+
+// ClientOption configures a Client created by NewClientWithOptions or NewClientFromEnv
+type ClientOption func(*clientOptions)
+
+// WithBaseURL sets the base URL of the API, a trailing slash is added if missing.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(o *clientOptions) { o.baseURL = baseURL }
+}
+
+// WithCredentials authenticates requests using HTTP Basic Authentication with the given username and password.
+func WithCredentials(username, password string) ClientOption {
+	return func(o *clientOptions) { o.username, o.password = username, password }
+}
+
+// WithHTTPClient sets the http.Client used to send requests, it is not modified by other options.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(o *clientOptions) { o.httpClient = httpClient }
+}
+
+// WithUserAgent sets the User-Agent header sent with requests.
+func WithUserAgent(userAgent string) ClientOption {
+	return func(o *clientOptions) { o.userAgent = &userAgent }
+}
+
+// WithTimeout sets the time limit of each attempt to send a request, including reading the response.
+// It also applies to UploadStorageImport, so set it above the longest expected upload or leave it
+// unset and bound individual calls with a context deadline instead.
+func WithTimeout(timeout time.Duration) ClientOption {
+	return func(o *clientOptions) { o.timeout = timeout }
+}
+
+// WithRetryPolicy sets the RetryPolicy of the Client.
+func WithRetryPolicy(p *RetryPolicy) ClientOption {
+	return func(o *clientOptions) { o.retryPolicy = p }
+}
+
+// WithLimiter sets the Limiter of the Client.
+func WithLimiter(l Limiter) ClientOption {
+	return func(o *clientOptions) { o.limiter = l }
+}
+
+// WithMaxInFlight limits the number of concurrent requests, see Client.SetMaxInFlight.
+func WithMaxInFlight(n int) ClientOption {
+	return func(o *clientOptions) { o.maxInFlight = n }
+}
+
+// NewClientWithOptions returns a new Upcloud API Client configured by opts.
+// Later options take precedence over earlier ones.
+func NewClientWithOptions(opts ...ClientOption) (*Client, error) {
+	o := &clientOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return newClientWithOptions(o)
+}
+
+// newClientWithOptions returns a new Client configured by o
+func newClientWithOptions(o *clientOptions) (*Client, error) {
+	httpClient := &http.Client{}
+	if o.httpClient != nil {
+		// Copy so the caller's client is left untouched
+		*httpClient = *o.httpClient
+	}
+	if o.username != "" || o.password != "" {
+		httpClient.Transport = &BasicAuthTransport{
+			Username:  o.username,
+			Password:  o.password,
+			Transport: httpClient.Transport,
+		}
+	}
+	if o.timeout > 0 {
+		httpClient.Timeout = o.timeout
+	}
+
+	c := NewClient(httpClient)
+	if o.baseURL != "" {
+		baseURL := o.baseURL
+		if !strings.HasSuffix(baseURL, "/") {
+			baseURL += "/"
+		}
+		u, err := url.Parse(baseURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid base URL %q: %v", o.baseURL, err)
+		}
+		if u.Scheme == "" || u.Host == "" {
+			return nil, fmt.Errorf("invalid base URL %q: must be absolute", o.baseURL)
+		}
+		c.BaseURL = u
+	}
+	if o.userAgent != nil {
+		c.UserAgent = *o.userAgent
+	}
+	c.RetryPolicy = o.retryPolicy
+	c.Limiter = o.limiter
+	c.SetMaxInFlight(o.maxInFlight)
+
+	return c, nil
+}
+
+// NewClientFromEnv returns a new Upcloud API Client using credentials from the environment.
+// The profile named by UPCLOUD_PROFILE, or "default", is read from the INI file named by
+// UPCLOUD_CONFIG_FILE, or ~/.upcloud/config if it exists:
+//
+//	[default]
+//	username = user
+//	password = secret
+//	base_url = https://api.upcloud.com/1.3/
+//
+// UPCLOUD_USERNAME, UPCLOUD_PASSWORD and UPCLOUD_BASE_URL override the profile, and opts override both.
+func NewClientFromEnv(opts ...ClientOption) (*Client, error) {
+	profile := map[string]string{}
+
+	name, explicitProfile := os.LookupEnv(EnvProfile)
+	if !explicitProfile || name == "" {
+		name = defaultProfile
+	}
+	path, explicitPath := os.LookupEnv(EnvConfigFile)
+	if !explicitPath || path == "" {
+		if home, err := os.UserHomeDir(); err == nil {
+			path = filepath.Join(home, defaultConfigFile)
+		}
+	}
+	if path != "" {
+		profiles, err := readConfigFile(path)
+		switch {
+		case err == nil:
+			p, ok := profiles[name]
+			if !ok && explicitProfile {
+				return nil, fmt.Errorf("profile %q not found in %v", name, path)
+			}
+			if ok {
+				profile = p
+			}
+		case os.IsNotExist(err) && !explicitPath:
+		default:
+			return nil, err
+		}
+	}
+
+	if v := os.Getenv(EnvUsername); v != "" {
+		profile["username"] = v
+	}
+	if v := os.Getenv(EnvPassword); v != "" {
+		profile["password"] = v
+	}
+	if v := os.Getenv(EnvBaseURL); v != "" {
+		profile["base_url"] = v
+	}
+
+	o := &clientOptions{
+		username: profile["username"],
+		password: profile["password"],
+		baseURL:  profile["base_url"],
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.username == "" || o.password == "" {
+		return nil, errors.New("no UpCloud credentials found in options, environment or config file")
+	}
+	return newClientWithOptions(o)
+}
+
+// readConfigFile parses an INI file into a map of sections, each a map of keys to values.
+// Blank lines and lines starting with # or ; are ignored.
+func readConfigFile(path string) (map[string]map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	profiles := map[string]map[string]string{}
+	var section map[string]string
+	scanner := bufio.NewScanner(f)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "", strings.HasPrefix(line, "#"), strings.HasPrefix(line, ";"):
+			continue
+		case strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]"):
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			if profiles[name] == nil {
+				profiles[name] = map[string]string{}
+			}
+			section = profiles[name]
+			continue
+		}
+
+		i := strings.Index(line, "=")
+		if i < 0 || section == nil {
+			return nil, fmt.Errorf("%v:%d: expected key = value in a [profile] section", path, n)
+		}
+		key := strings.TrimSpace(line[:i])
+		value := strings.TrimSpace(line[i+1:])
+		// Remove one pair of matching quotes around the value
+		if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+			value = value[1 : len(value)-1]
+		}
+		section[key] = value
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return profiles, nil
+}
@@ -0,0 +1,635 @@
+package upcloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// DatabasesService handles communication with the managed database related methods of the UpCloud API
+// https://developers.upcloud.com/1.3/20-managed-databases/
+type DatabasesService service
+
+// Managed database types
+const (
+	DatabaseTypePostgreSQL = "pg"
+	DatabaseTypeMySQL      = "mysql"
+	DatabaseTypeRedis      = "redis"
+)
+
+// Managed database states
+const (
+	DatabaseStateRunning    = "running"
+	DatabaseStatePoweroff   = "poweroff"
+	DatabaseStateRebuilding = "rebuilding"
+)
+
+// Connection pool modes
+const (
+	ConnectionPoolModeSession     = "session"
+	ConnectionPoolModeTransaction = "transaction"
+	ConnectionPoolModeStatement   = "statement"
+)
+
+// Metrics periods
+const (
+	DatabaseMetricsPeriodHour  = "hour"
+	DatabaseMetricsPeriodDay   = "day"
+	DatabaseMetricsPeriodWeek  = "week"
+	DatabaseMetricsPeriodMonth = "month"
+	DatabaseMetricsPeriodYear  = "year"
+)
+
+// DatabasePlan represents a plan available for a managed database type
+type DatabasePlan struct {
+	Plan         string `json:"plan"`
+	NodeCount    int    `json:"node_count"`
+	CoreNumber   int    `json:"core_number"`
+	MemoryAmount int    `json:"memory_amount"` //MiB
+	StorageSize  int    `json:"storage_size"`  //MiB
+}
+
+// DatabaseType represents a managed database type and its plans
+type DatabaseType struct {
+	Name                   string                 `json:"name"`
+	Description            string                 `json:"description"`
+	LatestAvailableVersion string                 `json:"latest_available_version"`
+	ServicePlans           []DatabasePlan         `json:"service_plans"`
+	Properties             map[string]interface{} `json:"properties,omitempty"`
+}
+
+// DatabaseMaintenance represents the weekly maintenance window of a managed database
+type DatabaseMaintenance struct {
+	DOW  string `json:"dow"`  //monday..sunday
+	Time string `json:"time"` //hh:mm:ss
+}
+
+// DatabaseURIParams represents the connection parameters of a managed database
+type DatabaseURIParams struct {
+	DatabaseName string `json:"dbname"`
+	Host         string `json:"host"`
+	Password     string `json:"password"`
+	Port         string `json:"port"`
+	SSLMode      string `json:"ssl_mode"`
+	User         string `json:"user"`
+}
+
+// Database represents a managed database service
+type Database struct {
+	UUID                  string                 `json:"uuid"`
+	Name                  string                 `json:"name"`
+	Title                 string                 `json:"title"`
+	Type                  string                 `json:"type"` //pg/mysql/redis
+	Plan                  string                 `json:"plan"`
+	Zone                  string                 `json:"zone"`
+	State                 string                 `json:"state"` //running/poweroff/rebuilding
+	Powered               bool                   `json:"powered"`
+	NodeCount             int                    `json:"node_count"`
+	CoreNumber            int                    `json:"core_number"`
+	MemoryAmount          int                    `json:"memory_amount"` //MiB
+	StorageSize           int                    `json:"storage_size"`  //MiB
+	ServiceURI            string                 `json:"service_uri"`
+	ServiceURIParams      DatabaseURIParams      `json:"service_uri_params"`
+	Maintenance           DatabaseMaintenance    `json:"maintenance"`
+	Properties            map[string]interface{} `json:"properties,omitempty"`
+	TerminationProtection bool                   `json:"termination_protection"`
+	CreateTime            time.Time              `json:"create_time"`
+	UpdateTime            time.Time              `json:"update_time"`
+}
+
+// ListDatabaseTypes returns the available managed database types keyed by type.
+// https://developers.upcloud.com/1.3/20-managed-databases/#list-available-database-types
+func (s *DatabasesService) ListDatabaseTypes(ctx context.Context) (map[string]DatabaseType, *http.Response, error) {
+	req, err := s.client.NewRequest("GET", "database/service-types", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var types map[string]DatabaseType
+	resp, err := s.client.Do(ctx, req, &types)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return types, resp, nil
+}
+
+// GetDatabaseType returns the managed database type with the given name, e.g. pg.
+// https://developers.upcloud.com/1.3/20-managed-databases/#get-database-type-details
+func (s *DatabasesService) GetDatabaseType(ctx context.Context, dbType string) (*DatabaseType, *http.Response, error) {
+	u := fmt.Sprintf("database/service-types/%v", dbType)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	t := new(DatabaseType)
+	resp, err := s.client.Do(ctx, req, t)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return t, resp, nil
+}
+
+// ListDatabasePlans returns the plans available for the given managed database type.
+func (s *DatabasesService) ListDatabasePlans(ctx context.Context, dbType string) ([]DatabasePlan, *http.Response, error) {
+	t, resp, err := s.GetDatabaseType(ctx, dbType)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return t.ServicePlans, resp, nil
+}
+
+// ListDatabases returns the managed databases on the account.
+// https://developers.upcloud.com/1.3/20-managed-databases/#list-managed-databases
+func (s *DatabasesService) ListDatabases(ctx context.Context) ([]Database, *http.Response, error) {
+	req, err := s.client.NewRequest("GET", "database", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var databases []Database
+	resp, err := s.client.Do(ctx, req, &databases)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return databases, resp, nil
+}
+
+// GetDatabaseDetails returns the managed database with the given uuid.
+// https://developers.upcloud.com/1.3/20-managed-databases/#get-managed-database-details
+func (s *DatabasesService) GetDatabaseDetails(ctx context.Context, uuid string) (*Database, *http.Response, error) {
+	u := fmt.Sprintf("database/%v", uuid)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	database := new(Database)
+	resp, err := s.client.Do(ctx, req, database)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return database, resp, nil
+}
+
+// CreateDatabaseRequest represents the properties of a new managed database
+type CreateDatabaseRequest struct {
+	HostnamePrefix        string                 `json:"hostname_prefix"`
+	Plan                  string                 `json:"plan"`
+	Title                 string                 `json:"title"`
+	Type                  string                 `json:"type"` //pg/mysql/redis
+	Zone                  string                 `json:"zone"`
+	Maintenance           *DatabaseMaintenance   `json:"maintenance,omitempty"`
+	Properties            map[string]interface{} `json:"properties,omitempty"`
+	TerminationProtection bool                   `json:"termination_protection,omitempty"`
+}
+
+// CreateDatabase creates a new managed database with the properties defined in r.
+// https://developers.upcloud.com/1.3/20-managed-databases/#create-managed-database
+func (s *DatabasesService) CreateDatabase(ctx context.Context, r *CreateDatabaseRequest) (*Database, *http.Response, error) {
+	req, err := s.client.NewRequest("POST", "database", r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	database := new(Database)
+	resp, err := s.client.Do(ctx, req, database)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return database, resp, nil
+}
+
+// ModifyDatabaseRequest represents the properties of a managed database to modify, empty values are left unchanged
+type ModifyDatabaseRequest struct {
+	Title                 string                 `json:"title,omitempty"`
+	Plan                  string                 `json:"plan,omitempty"`
+	Zone                  string                 `json:"zone,omitempty"`
+	Maintenance           *DatabaseMaintenance   `json:"maintenance,omitempty"`
+	Properties            map[string]interface{} `json:"properties,omitempty"`
+	Powered               *bool                  `json:"powered,omitempty"`
+	TerminationProtection *bool                  `json:"termination_protection,omitempty"`
+}
+
+// ModifyDatabase modifies the managed database with the given uuid using the properties defined in r.
+// https://developers.upcloud.com/1.3/20-managed-databases/#modify-managed-database
+func (s *DatabasesService) ModifyDatabase(ctx context.Context, uuid string, r *ModifyDatabaseRequest) (*Database, *http.Response, error) {
+	u := fmt.Sprintf("database/%v", uuid)
+	req, err := s.client.NewRequest("PATCH", u, r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	database := new(Database)
+	resp, err := s.client.Do(ctx, req, database)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return database, resp, nil
+}
+
+// StartDatabase powers on the managed database with the given uuid.
+// https://developers.upcloud.com/1.3/20-managed-databases/#start-managed-database
+func (s *DatabasesService) StartDatabase(ctx context.Context, uuid string) (*Database, *http.Response, error) {
+	powered := true
+	return s.ModifyDatabase(ctx, uuid, &ModifyDatabaseRequest{Powered: &powered})
+}
+
+// StopDatabase powers off the managed database with the given uuid.
+// https://developers.upcloud.com/1.3/20-managed-databases/#shut-down-managed-database
+func (s *DatabasesService) StopDatabase(ctx context.Context, uuid string) (*Database, *http.Response, error) {
+	powered := false
+	return s.ModifyDatabase(ctx, uuid, &ModifyDatabaseRequest{Powered: &powered})
+}
+
+// DeleteDatabase deletes the managed database with the given uuid and all its data.
+// https://developers.upcloud.com/1.3/20-managed-databases/#delete-managed-database
+func (s *DatabasesService) DeleteDatabase(ctx context.Context, uuid string) (*http.Response, error) {
+	u := fmt.Sprintf("database/%v", uuid)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// LogicalDatabase represents a database within a managed database service
+type LogicalDatabase struct {
+	Name      string `json:"name"`
+	LCCollate string `json:"lc_collate,omitempty"` //PostgreSQL only
+	LCCType   string `json:"lc_ctype,omitempty"`   //PostgreSQL only
+}
+
+// ListLogicalDatabases returns the logical databases of the managed database with the given uuid.
+// https://developers.upcloud.com/1.3/20-managed-databases/#list-logical-databases
+func (s *DatabasesService) ListLogicalDatabases(ctx context.Context, uuid string) ([]LogicalDatabase, *http.Response, error) {
+	u := fmt.Sprintf("database/%v/databases", uuid)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var databases []LogicalDatabase
+	resp, err := s.client.Do(ctx, req, &databases)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return databases, resp, nil
+}
+
+// CreateLogicalDatabase creates a logical database in the managed database with the given uuid.
+// https://developers.upcloud.com/1.3/20-managed-databases/#create-logical-database
+func (s *DatabasesService) CreateLogicalDatabase(ctx context.Context, uuid string, db *LogicalDatabase) (*LogicalDatabase, *http.Response, error) {
+	u := fmt.Sprintf("database/%v/databases", uuid)
+	req, err := s.client.NewRequest("POST", u, db)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created := new(LogicalDatabase)
+	resp, err := s.client.Do(ctx, req, created)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return created, resp, nil
+}
+
+// DeleteLogicalDatabase deletes the logical database with the given name.
+// https://developers.upcloud.com/1.3/20-managed-databases/#delete-logical-database
+func (s *DatabasesService) DeleteLogicalDatabase(ctx context.Context, uuid string, name string) (*http.Response, error) {
+	u := fmt.Sprintf("database/%v/databases/%v", uuid, name)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// DatabaseUser represents a user of a managed database service
+type DatabaseUser struct {
+	Username       string `json:"username,omitempty"` //Implied by URL on modify
+	Password       string `json:"password,omitempty"`
+	Type           string `json:"type,omitempty"`           //primary/normal
+	Authentication string `json:"authentication,omitempty"` //MySQL only, e.g. caching_sha2_password
+}
+
+// ListDatabaseUsers returns the users of the managed database with the given uuid.
+// https://developers.upcloud.com/1.3/20-managed-databases/#list-users
+func (s *DatabasesService) ListDatabaseUsers(ctx context.Context, uuid string) ([]DatabaseUser, *http.Response, error) {
+	u := fmt.Sprintf("database/%v/users", uuid)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var users []DatabaseUser
+	resp, err := s.client.Do(ctx, req, &users)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return users, resp, nil
+}
+
+// CreateDatabaseUser creates a user in the managed database with the given uuid.
+// A password is generated when user.Password is empty.
+// https://developers.upcloud.com/1.3/20-managed-databases/#create-user
+func (s *DatabasesService) CreateDatabaseUser(ctx context.Context, uuid string, user *DatabaseUser) (*DatabaseUser, *http.Response, error) {
+	u := fmt.Sprintf("database/%v/users", uuid)
+	req, err := s.client.NewRequest("POST", u, user)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created := new(DatabaseUser)
+	resp, err := s.client.Do(ctx, req, created)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return created, resp, nil
+}
+
+// ModifyDatabaseUser modifies the password or authentication of the user with the given username.
+// https://developers.upcloud.com/1.3/20-managed-databases/#modify-user
+func (s *DatabasesService) ModifyDatabaseUser(ctx context.Context, uuid string, username string, user *DatabaseUser) (*DatabaseUser, *http.Response, error) {
+	trimUser := *user
+	trimUser.Username = ""
+	trimUser.Type = ""
+	u := fmt.Sprintf("database/%v/users/%v", uuid, username)
+	req, err := s.client.NewRequest("PATCH", u, &trimUser)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	modified := new(DatabaseUser)
+	resp, err := s.client.Do(ctx, req, modified)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return modified, resp, nil
+}
+
+// DeleteDatabaseUser deletes the user with the given username.
+// https://developers.upcloud.com/1.3/20-managed-databases/#delete-user
+func (s *DatabasesService) DeleteDatabaseUser(ctx context.Context, uuid string, username string) (*http.Response, error) {
+	u := fmt.Sprintf("database/%v/users/%v", uuid, username)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ConnectionPool represents a PgBouncer connection pool of a PostgreSQL managed database
+type ConnectionPool struct {
+	PoolName      string `json:"pool_name,omitempty"` //Implied by URL on modify
+	Database      string `json:"database,omitempty"`
+	PoolMode      string `json:"pool_mode,omitempty"` //session/transaction/statement
+	PoolSize      int    `json:"pool_size,omitempty"`
+	Username      string `json:"username,omitempty"`
+	ConnectionURI string `json:"connection_uri,omitempty"`
+}
+
+// ListConnectionPools returns the connection pools of the managed database with the given uuid.
+// https://developers.upcloud.com/1.3/20-managed-databases/#list-connection-pools
+func (s *DatabasesService) ListConnectionPools(ctx context.Context, uuid string) ([]ConnectionPool, *http.Response, error) {
+	u := fmt.Sprintf("database/%v/connection-pools", uuid)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var pools []ConnectionPool
+	resp, err := s.client.Do(ctx, req, &pools)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return pools, resp, nil
+}
+
+// CreateConnectionPool creates a connection pool in the managed database with the given uuid.
+// https://developers.upcloud.com/1.3/20-managed-databases/#create-connection-pool
+func (s *DatabasesService) CreateConnectionPool(ctx context.Context, uuid string, pool *ConnectionPool) (*ConnectionPool, *http.Response, error) {
+	u := fmt.Sprintf("database/%v/connection-pools", uuid)
+	req, err := s.client.NewRequest("POST", u, pool)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created := new(ConnectionPool)
+	resp, err := s.client.Do(ctx, req, created)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return created, resp, nil
+}
+
+// ModifyConnectionPool modifies the connection pool with the given name.
+// https://developers.upcloud.com/1.3/20-managed-databases/#modify-connection-pool
+func (s *DatabasesService) ModifyConnectionPool(ctx context.Context, uuid string, name string, pool *ConnectionPool) (*ConnectionPool, *http.Response, error) {
+	trimPool := *pool
+	trimPool.PoolName = ""
+	trimPool.ConnectionURI = ""
+	u := fmt.Sprintf("database/%v/connection-pools/%v", uuid, name)
+	req, err := s.client.NewRequest("PATCH", u, &trimPool)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	modified := new(ConnectionPool)
+	resp, err := s.client.Do(ctx, req, modified)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return modified, resp, nil
+}
+
+// DeleteConnectionPool deletes the connection pool with the given name.
+// https://developers.upcloud.com/1.3/20-managed-databases/#delete-connection-pool
+func (s *DatabasesService) DeleteConnectionPool(ctx context.Context, uuid string, name string) (*http.Response, error) {
+	u := fmt.Sprintf("database/%v/connection-pools/%v", uuid, name)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// DatabaseMetricsColumn represents a column of a metrics chart
+type DatabaseMetricsColumn struct {
+	Label string `json:"label"`
+	Type  string `json:"type"`
+}
+
+// DatabaseMetricsChart represents a chart of metrics, each row starts with a timestamp followed by one value per node
+type DatabaseMetricsChart struct {
+	Data struct {
+		Columns []DatabaseMetricsColumn `json:"cols"`
+		Rows    [][]interface{}         `json:"rows"`
+	} `json:"data"`
+	Hints struct {
+		Title string `json:"title"`
+	} `json:"hints"`
+}
+
+// GetDatabaseMetrics returns the metrics charts of the managed database with the given uuid keyed by metric,
+// e.g. cpu_usage or disk_usage, over period.
+// https://developers.upcloud.com/1.3/20-managed-databases/#get-managed-database-metrics
+func (s *DatabasesService) GetDatabaseMetrics(ctx context.Context, uuid string, period string) (map[string]DatabaseMetricsChart, *http.Response, error) {
+	q := url.Values{}
+	q.Set("period", period)
+	u := fmt.Sprintf("database/%v/metrics?%v", uuid, q.Encode())
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var charts map[string]DatabaseMetricsChart
+	resp, err := s.client.Do(ctx, req, &charts)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return charts, resp, nil
+}
+
+// DatabaseLogEntry represents a single log line of a managed database
+type DatabaseLogEntry struct {
+	Time     time.Time `json:"time"`
+	Message  string    `json:"message"`
+	Hostname string    `json:"hostname"`
+	Service  string    `json:"service"`
+}
+
+// DatabaseLogs represents a page of managed database logs
+type DatabaseLogs struct {
+	Offset         string             `json:"offset"` //Pass as Offset to fetch the next page
+	FirstLogOffset string             `json:"first_log_offset"`
+	Logs           []DatabaseLogEntry `json:"logs"`
+}
+
+// DatabaseLogsOptions controls which page of logs is returned
+type DatabaseLogsOptions struct {
+	Limit  int    // Number of entries, 1-1000
+	Offset string // Offset returned by a previous call
+	Order  string // asc/desc
+}
+
+// GetDatabaseLogs returns a page of the logs of the managed database with the given uuid.
+// https://developers.upcloud.com/1.3/20-managed-databases/#get-managed-database-logs
+func (s *DatabasesService) GetDatabaseLogs(ctx context.Context, uuid string, opts *DatabaseLogsOptions) (*DatabaseLogs, *http.Response, error) {
+	u := fmt.Sprintf("database/%v/logs", uuid)
+	if opts != nil {
+		q := url.Values{}
+		if opts.Limit > 0 {
+			q.Set("limit", strconv.Itoa(opts.Limit))
+		}
+		if opts.Offset != "" {
+			q.Set("offset", opts.Offset)
+		}
+		if opts.Order != "" {
+			q.Set("order", opts.Order)
+		}
+		if len(q) > 0 {
+			u += "?" + q.Encode()
+		}
+	}
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	logs := new(DatabaseLogs)
+	resp, err := s.client.Do(ctx, req, logs)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return logs, resp, nil
+}
+
+// DatabaseBackup represents a backup of a managed database
+type DatabaseBackup struct {
+	BackupName string    `json:"backup_name"`
+	BackupTime time.Time `json:"backup_time"`
+	DataSize   int64     `json:"data_size"` //Bytes
+}
+
+// ListDatabaseBackups returns the backups of the managed database with the given uuid.
+// https://developers.upcloud.com/1.3/20-managed-databases/#list-backups
+func (s *DatabasesService) ListDatabaseBackups(ctx context.Context, uuid string) ([]DatabaseBackup, *http.Response, error) {
+	u := fmt.Sprintf("database/%v/backups", uuid)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var backups []DatabaseBackup
+	resp, err := s.client.Do(ctx, req, &backups)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return backups, resp, nil
+}
+
+// CreateDatabaseBackup triggers a backup of the managed database with the given uuid outside the regular schedule.
+// https://developers.upcloud.com/1.3/20-managed-databases/#create-backup
+func (s *DatabasesService) CreateDatabaseBackup(ctx context.Context, uuid string) (*http.Response, error) {
+	u := fmt.Sprintf("database/%v/backups", uuid)
+	req, err := s.client.NewRequest("POST", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// RestoreDatabaseRequest represents the properties of a managed database restored from a backup
+type RestoreDatabaseRequest struct {
+	HostnamePrefix string     `json:"hostname_prefix"`
+	Plan           string     `json:"plan"`
+	Title          string     `json:"title"`
+	Zone           string     `json:"zone"`
+	BackupTime     *time.Time `json:"backup_time,omitempty"` //Point in time to restore, latest when nil
+}
+
+// RestoreDatabaseBackup restores the managed database with the given uuid into a new service.
+// Backups are restored by cloning so the original service is left intact.
+// https://developers.upcloud.com/1.3/20-managed-databases/#clone-managed-database
+func (s *DatabasesService) RestoreDatabaseBackup(ctx context.Context, uuid string, r *RestoreDatabaseRequest) (*Database, *http.Response, error) {
+	u := fmt.Sprintf("database/%v/clone", uuid)
+	req, err := s.client.NewRequest("POST", u, r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	database := new(Database)
+	resp, err := s.client.Do(ctx, req, database)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return database, resp, nil
+}
@@ -0,0 +1,164 @@
+package upcloud
+
+import (
+	"encoding/json"
+	"errors"
+	"mime"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxErrorBodyLength is the number of bytes of an error response body kept in ErrorResponse.Body
+const maxErrorBodyLength = 512
+
+// APIError represents the error object returned by the UpCloud API
+// https://developers.upcloud.com/1.3/2-responses/#error-responses
+type APIError struct {
+	Message string `json:"error_message"`
+	Code    string `json:"error_code"`
+}
+
+// problemDetails represents an RFC 7807 error body as returned by the newer UpCloud APIs
+type problemDetails struct {
+	Type   string `json:"type"`
+	Title  string `json:"title"`
+	Detail string `json:"detail"`
+}
+
+// newErrorResponse builds the ErrorResponse for a non-2xx resp with body data.
+// Bodies that cannot be decoded are kept only as the raw Body.
+func newErrorResponse(resp *http.Response, data []byte) *ErrorResponse {
+	r := &ErrorResponse{Response: resp}
+	r.RequestID = resp.Header.Get("X-Request-Id")
+	if r.RequestID == "" {
+		r.RequestID = resp.Header.Get("X-Correlation-Id")
+	}
+
+	body := strings.TrimSpace(string(data))
+	if len(body) > maxErrorBodyLength {
+		// Cut at a rune boundary so the body stays valid UTF-8
+		n := maxErrorBodyLength
+		for n > 0 && !utf8.RuneStart(body[n]) {
+			n--
+		}
+		body = body[:n] + "..."
+	}
+	r.Body = body
+	if len(data) == 0 {
+		return r
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if mediaType == "application/problem+json" {
+		var p problemDetails
+		if err := json.Unmarshal(data, &p); err == nil && (p.Type != "" || p.Title != "") {
+			// The error code is the fragment of the type URI, e.g. .../errors#ERROR_NOT_FOUND
+			code := p.Type
+			if i := strings.LastIndex(code, "#"); i >= 0 {
+				code = code[i+1:]
+			}
+			msg := p.Title
+			if p.Detail != "" {
+				msg += ": " + p.Detail
+			}
+			r.APIError = &APIError{Code: code, Message: msg}
+		}
+		return r
+	}
+
+	var e struct {
+		APIError *APIError `json:"error"`
+	}
+	if err := json.Unmarshal(data, &e); err == nil {
+		r.APIError = e.APIError
+	}
+	return r
+}
+
+// Error categories matched by an ErrorResponse with errors.Is
+var (
+	ErrNotFound             = errors.New("upcloud: resource not found")
+	ErrForbidden            = errors.New("upcloud: action forbidden")
+	ErrResourceBusy         = errors.New("upcloud: resource busy or in an illegal state")
+	ErrLimitExceeded        = errors.New("upcloud: limit exceeded")
+	ErrInvalidParameter     = errors.New("upcloud: invalid parameter")
+	ErrAuthenticationFailed = errors.New("upcloud: authentication failed")
+)
+
+// category returns the sentinel error of r, derived from the UpCloud error code
+// and falling back to the HTTP status, or nil if it has no category.
+func (r *ErrorResponse) category() error {
+	if r.APIError != nil {
+		code := r.APIError.Code
+		switch {
+		case code == "AUTHENTICATION_FAILED":
+			return ErrAuthenticationFailed
+		case strings.HasSuffix(code, "_NOT_FOUND"):
+			return ErrNotFound
+		case strings.HasSuffix(code, "_FORBIDDEN"), code == "INSUFFICIENT_PERMISSIONS":
+			return ErrForbidden
+		case strings.HasSuffix(code, "_STATE_ILLEGAL"), strings.HasSuffix(code, "_BUSY"):
+			return ErrResourceBusy
+		case strings.HasSuffix(code, "_LIMIT_REACHED"), strings.HasSuffix(code, "_LIMIT_EXCEEDED"):
+			return ErrLimitExceeded
+		case strings.HasSuffix(code, "_INVALID"), strings.HasSuffix(code, "_MISSING"), strings.HasSuffix(code, "_ILLEGAL"):
+			return ErrInvalidParameter
+		}
+	}
+
+	if r.Response == nil {
+		return nil
+	}
+	switch r.Response.StatusCode {
+	case http.StatusUnauthorized:
+		return ErrAuthenticationFailed
+	case http.StatusForbidden:
+		return ErrForbidden
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusConflict:
+		return ErrResourceBusy
+	case http.StatusTooManyRequests:
+		return ErrLimitExceeded
+	case http.StatusBadRequest:
+		return ErrInvalidParameter
+	}
+	return nil
+}
+
+// Is reports whether target is the error category of r.
+func (r *ErrorResponse) Is(target error) bool {
+	c := r.category()
+	return c != nil && c == target
+}
+
+// IsNotFound reports whether err is an API error for a resource that does not exist.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsForbidden reports whether err is an API error for an action the account may not perform.
+func IsForbidden(err error) bool {
+	return errors.Is(err, ErrForbidden)
+}
+
+// IsResourceBusy reports whether err is an API error for a resource in a state that does not allow the action.
+func IsResourceBusy(err error) bool {
+	return errors.Is(err, ErrResourceBusy)
+}
+
+// IsLimitExceeded reports whether err is an API error for an exceeded account or rate limit.
+func IsLimitExceeded(err error) bool {
+	return errors.Is(err, ErrLimitExceeded)
+}
+
+// IsInvalidParameter reports whether err is an API error for a missing or invalid request parameter.
+func IsInvalidParameter(err error) bool {
+	return errors.Is(err, ErrInvalidParameter)
+}
+
+// IsAuthenticationFailed reports whether err is an API error for invalid credentials.
+func IsAuthenticationFailed(err error) bool {
+	return errors.Is(err, ErrAuthenticationFailed)
+}
@@ -0,0 +1,176 @@
+package upcloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestDoErrorResponseBodies(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		contentType string
+		body        string
+		wantCode    string
+		wantBody    string
+		wantMessage string
+	}{
+		{
+			name:        "html",
+			status:      http.StatusBadGateway,
+			contentType: "text/html",
+			body:        "<html>bad gateway</html>",
+			wantBody:    "<html>bad gateway</html>",
+			wantMessage: "502 <html>bad gateway</html>",
+		},
+		{
+			name:        "empty",
+			status:      http.StatusNotFound,
+			wantMessage: "404 Not Found",
+		},
+		{
+			name:        "upcloud json",
+			status:      http.StatusNotFound,
+			contentType: "application/json",
+			body:        `{"error":{"error_code":"SERVER_NOT_FOUND","error_message":"The server does not exist."}}`,
+			wantCode:    "SERVER_NOT_FOUND",
+			wantBody:    `{"error":{"error_code":"SERVER_NOT_FOUND","error_message":"The server does not exist."}}`,
+			wantMessage: "404 SERVER_NOT_FOUND - The server does not exist.",
+		},
+		{
+			name:        "problem json",
+			status:      http.StatusNotFound,
+			contentType: "application/problem+json",
+			body:        `{"type":"https://developers.upcloud.com/1.3/errors#ERROR_RESOURCE_NOT_FOUND","title":"Resource not found","status":404}`,
+			wantCode:    "ERROR_RESOURCE_NOT_FOUND",
+			wantBody:    `{"type":"https://developers.upcloud.com/1.3/errors#ERROR_RESOURCE_NOT_FOUND","title":"Resource not found","status":404}`,
+			wantMessage: "404 ERROR_RESOURCE_NOT_FOUND - Resource not found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("X-Request-Id", "req-1")
+				if tt.contentType != "" {
+					w.Header().Set("Content-Type", tt.contentType)
+				}
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			})
+			c.RetryPolicy = nil
+
+			req, _ := c.NewRequest("GET", "server/x", nil)
+			_, err := c.Do(context.Background(), req, &struct{}{})
+			var errResp *ErrorResponse
+			if !errors.As(err, &errResp) {
+				t.Fatalf("Do returned %v, want *ErrorResponse", err)
+			}
+			if errResp.Response.StatusCode != tt.status {
+				t.Errorf("status %d, want %d", errResp.Response.StatusCode, tt.status)
+			}
+			if errResp.RequestID != "req-1" {
+				t.Errorf("RequestID %q, want %q", errResp.RequestID, "req-1")
+			}
+			if errResp.Body != tt.wantBody {
+				t.Errorf("Body %q, want %q", errResp.Body, tt.wantBody)
+			}
+			var code string
+			if errResp.APIError != nil {
+				code = errResp.APIError.Code
+			}
+			if code != tt.wantCode {
+				t.Errorf("APIError code %q, want %q", code, tt.wantCode)
+			}
+			if msg := errResp.Error(); !strings.Contains(msg, tt.wantMessage) || !strings.Contains(msg, "req-1") {
+				t.Errorf("Error() = %q, want it to contain %q and the request id", msg, tt.wantMessage)
+			}
+		})
+	}
+}
+
+func TestErrorResponseZeroValueError(t *testing.T) {
+	if msg := (&ErrorResponse{}).Error(); msg == "" {
+		t.Error("Error() of zero ErrorResponse is empty")
+	}
+}
+
+func TestNewErrorResponseTruncatesAtRuneBoundary(t *testing.T) {
+	// One byte of padding puts a three byte rune across the truncation point
+	data := []byte("x" + strings.Repeat("€", maxErrorBodyLength))
+	r := newErrorResponse(&http.Response{StatusCode: http.StatusBadGateway, Header: http.Header{}}, data)
+
+	if !utf8.ValidString(r.Body) {
+		t.Errorf("Body is not valid UTF-8")
+	}
+	if !strings.HasSuffix(r.Body, "...") || len(r.Body) > maxErrorBodyLength+len("...") {
+		t.Errorf("Body has length %d, want at most %d ending in ...", len(r.Body), maxErrorBodyLength+len("..."))
+	}
+}
+
+func TestErrorResponseCategories(t *testing.T) {
+	sentinels := []struct {
+		err error
+		is  func(error) bool
+	}{
+		{ErrNotFound, IsNotFound},
+		{ErrForbidden, IsForbidden},
+		{ErrResourceBusy, IsResourceBusy},
+		{ErrLimitExceeded, IsLimitExceeded},
+		{ErrInvalidParameter, IsInvalidParameter},
+		{ErrAuthenticationFailed, IsAuthenticationFailed},
+	}
+
+	tests := []struct {
+		code   string
+		status int
+		want   error
+	}{
+		// Error codes take precedence over the status
+		{"AUTHENTICATION_FAILED", http.StatusBadRequest, ErrAuthenticationFailed},
+		{"SERVER_NOT_FOUND", http.StatusBadRequest, ErrNotFound},
+		{"ACTION_FORBIDDEN", http.StatusBadRequest, ErrForbidden},
+		{"INSUFFICIENT_PERMISSIONS", http.StatusBadRequest, ErrForbidden},
+		{"SERVER_STATE_ILLEGAL", http.StatusBadRequest, ErrResourceBusy},
+		{"STORAGE_BUSY", http.StatusBadRequest, ErrResourceBusy},
+		{"CORES_LIMIT_REACHED", http.StatusBadRequest, ErrLimitExceeded},
+		{"REQUEST_LIMIT_EXCEEDED", http.StatusBadRequest, ErrLimitExceeded},
+		{"TITLE_INVALID", http.StatusConflict, ErrInvalidParameter},
+		{"ZONE_MISSING", http.StatusConflict, ErrInvalidParameter},
+		{"ADDRESS_ILLEGAL", http.StatusConflict, ErrInvalidParameter},
+		// Unknown codes and missing error objects fall back to the status
+		{"SOMETHING_ELSE", http.StatusUnauthorized, ErrAuthenticationFailed},
+		{"", http.StatusForbidden, ErrForbidden},
+		{"", http.StatusNotFound, ErrNotFound},
+		{"", http.StatusConflict, ErrResourceBusy},
+		{"", http.StatusTooManyRequests, ErrLimitExceeded},
+		{"", http.StatusBadRequest, ErrInvalidParameter},
+		{"", http.StatusInternalServerError, nil},
+	}
+	for _, tt := range tests {
+		r := &ErrorResponse{Response: &http.Response{StatusCode: tt.status}}
+		if tt.code != "" {
+			r.APIError = &APIError{Code: tt.code}
+		}
+		err := fmt.Errorf("wrapped: %w", r)
+
+		for _, s := range sentinels {
+			want := s.err == tt.want
+			if got := errors.Is(err, s.err); got != want {
+				t.Errorf("code %q status %d: errors.Is(%v) = %v, want %v", tt.code, tt.status, s.err, got, want)
+			}
+			if got := s.is(err); got != want {
+				t.Errorf("code %q status %d: predicate for %v = %v, want %v", tt.code, tt.status, s.err, got, want)
+			}
+		}
+
+		var errResp *ErrorResponse
+		if !errors.As(err, &errResp) || errResp != r {
+			t.Errorf("code %q status %d: errors.As did not return the wrapped *ErrorResponse", tt.code, tt.status)
+		}
+	}
+}
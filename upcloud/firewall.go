@@ -0,0 +1,335 @@
+package upcloud
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+)
+
+// FirewallService handles communication with the firewall related methods of the UpCloud API
+// https://developers.upcloud.com/1.3/11-firewall/
+type FirewallService service
+
+// Firewall rule directions
+const (
+	FirewallDirectionIn  = "in"
+	FirewallDirectionOut = "out"
+)
+
+// Firewall rule actions
+const (
+	FirewallActionAccept = "accept"
+	FirewallActionReject = "reject"
+	FirewallActionDrop   = "drop"
+)
+
+// Firewall rule protocols
+const (
+	FirewallProtocolTCP  = "tcp"
+	FirewallProtocolUDP  = "udp"
+	FirewallProtocolICMP = "icmp"
+)
+
+const firewallCommentMaxLength = 250
+
+// FirewallRule represents a firewall rule of a server, empty values match anything
+type FirewallRule struct {
+	Action                  string `json:"action"`             //accept/reject/drop
+	Direction               string `json:"direction"`          //in/out
+	Family                  string `json:"family,omitempty"`   //IPv4/IPv6
+	Protocol                string `json:"protocol,omitempty"` //tcp/udp/icmp
+	ICMPType                string `json:"icmp_type,omitempty"`
+	Position                int    `json:"position,string,omitempty"` //1-1000, appended when empty
+	Comment                 string `json:"comment,omitempty"`
+	DestinationAddressStart string `json:"destination_address_start,omitempty"`
+	DestinationAddressEnd   string `json:"destination_address_end,omitempty"`
+	DestinationPortStart    string `json:"destination_port_start,omitempty"`
+	DestinationPortEnd      string `json:"destination_port_end,omitempty"`
+	SourceAddressStart      string `json:"source_address_start,omitempty"`
+	SourceAddressEnd        string `json:"source_address_end,omitempty"`
+	SourcePortStart         string `json:"source_port_start,omitempty"`
+	SourcePortEnd           string `json:"source_port_end,omitempty"`
+}
+
+// Validate reports whether the rule is consistent before it is sent to the API.
+func (r *FirewallRule) Validate() error {
+	switch r.Direction {
+	case FirewallDirectionIn, FirewallDirectionOut:
+	default:
+		return fmt.Errorf("firewall rule direction %q is invalid", r.Direction)
+	}
+
+	switch r.Action {
+	case FirewallActionAccept, FirewallActionReject, FirewallActionDrop:
+	default:
+		return fmt.Errorf("firewall rule action %q is invalid", r.Action)
+	}
+
+	switch r.Family {
+	case "", IPAddressFamilyIPv4, IPAddressFamilyIPv6:
+	default:
+		return fmt.Errorf("firewall rule family %q is invalid", r.Family)
+	}
+
+	switch r.Protocol {
+	case "":
+	case FirewallProtocolTCP, FirewallProtocolUDP, FirewallProtocolICMP:
+		if r.Family == "" {
+			return errors.New("firewall rule family is required when protocol is set")
+		}
+	default:
+		return fmt.Errorf("firewall rule protocol %q is invalid", r.Protocol)
+	}
+
+	if r.ICMPType != "" && r.Protocol != FirewallProtocolICMP {
+		return errors.New("firewall rule icmp_type requires the icmp protocol")
+	}
+
+	hasPorts := r.SourcePortStart != "" || r.SourcePortEnd != "" || r.DestinationPortStart != "" || r.DestinationPortEnd != ""
+	if hasPorts && r.Protocol != FirewallProtocolTCP && r.Protocol != FirewallProtocolUDP {
+		return errors.New("firewall rule ports require the tcp or udp protocol")
+	}
+	if err := validatePortRange("source", r.SourcePortStart, r.SourcePortEnd); err != nil {
+		return err
+	}
+	if err := validatePortRange("destination", r.DestinationPortStart, r.DestinationPortEnd); err != nil {
+		return err
+	}
+
+	if err := validateAddressRange("source", r.Family, r.SourceAddressStart, r.SourceAddressEnd); err != nil {
+		return err
+	}
+	if err := validateAddressRange("destination", r.Family, r.DestinationAddressStart, r.DestinationAddressEnd); err != nil {
+		return err
+	}
+
+	if r.Position < 0 || r.Position > 1000 {
+		return fmt.Errorf("firewall rule position %d must be between 1 and 1000", r.Position)
+	}
+	if len(r.Comment) > firewallCommentMaxLength {
+		return fmt.Errorf("firewall rule comment must be at most %d characters", firewallCommentMaxLength)
+	}
+	return nil
+}
+
+func validatePortRange(kind, start, end string) error {
+	if start == "" && end == "" {
+		return nil
+	}
+	s, err := strconv.Atoi(start)
+	if err != nil || s < 1 || s > 65535 {
+		return fmt.Errorf("firewall rule %v port start %q must be between 1 and 65535", kind, start)
+	}
+	e, err := strconv.Atoi(end)
+	if err != nil || e < 1 || e > 65535 {
+		return fmt.Errorf("firewall rule %v port end %q must be between 1 and 65535", kind, end)
+	}
+	if s > e {
+		return fmt.Errorf("firewall rule %v port range %v-%v is reversed", kind, s, e)
+	}
+	return nil
+}
+
+func validateAddressRange(kind, family, start, end string) error {
+	if start == "" && end == "" {
+		return nil
+	}
+	if family == "" {
+		return fmt.Errorf("firewall rule family is required when %v addresses are set", kind)
+	}
+	s := net.ParseIP(start)
+	if s == nil || (s.To4() != nil) != (family == IPAddressFamilyIPv4) {
+		return fmt.Errorf("firewall rule %v address start %q is not a valid %v address", kind, start, family)
+	}
+	e := net.ParseIP(end)
+	if e == nil || (e.To4() != nil) != (family == IPAddressFamilyIPv4) {
+		return fmt.Errorf("firewall rule %v address end %q is not a valid %v address", kind, end, family)
+	}
+	if bytes.Compare(s.To16(), e.To16()) > 0 {
+		return fmt.Errorf("firewall rule %v address range %v-%v is reversed", kind, start, end)
+	}
+	return nil
+}
+
+// FirewallRuleBuilder builds a FirewallRule that is validated by Build
+type FirewallRuleBuilder struct {
+	rule FirewallRule
+}
+
+// NewFirewallRule returns a builder for a rule with the given direction and action.
+func NewFirewallRule(direction, action string) *FirewallRuleBuilder {
+	return &FirewallRuleBuilder{rule: FirewallRule{Direction: direction, Action: action}}
+}
+
+// Family sets the IP family the rule matches.
+func (b *FirewallRuleBuilder) Family(family string) *FirewallRuleBuilder {
+	b.rule.Family = family
+	return b
+}
+
+// Protocol sets the protocol the rule matches.
+func (b *FirewallRuleBuilder) Protocol(protocol string) *FirewallRuleBuilder {
+	b.rule.Protocol = protocol
+	return b
+}
+
+// ICMPType sets the ICMP type the rule matches, the protocol must be icmp.
+func (b *FirewallRuleBuilder) ICMPType(icmpType int) *FirewallRuleBuilder {
+	b.rule.ICMPType = strconv.Itoa(icmpType)
+	return b
+}
+
+// SourceAddress sets the range of source addresses the rule matches.
+func (b *FirewallRuleBuilder) SourceAddress(start, end string) *FirewallRuleBuilder {
+	b.rule.SourceAddressStart = start
+	b.rule.SourceAddressEnd = end
+	return b
+}
+
+// DestinationAddress sets the range of destination addresses the rule matches.
+func (b *FirewallRuleBuilder) DestinationAddress(start, end string) *FirewallRuleBuilder {
+	b.rule.DestinationAddressStart = start
+	b.rule.DestinationAddressEnd = end
+	return b
+}
+
+// SourcePort sets the range of source ports the rule matches.
+func (b *FirewallRuleBuilder) SourcePort(start, end int) *FirewallRuleBuilder {
+	b.rule.SourcePortStart = strconv.Itoa(start)
+	b.rule.SourcePortEnd = strconv.Itoa(end)
+	return b
+}
+
+// DestinationPort sets the range of destination ports the rule matches.
+func (b *FirewallRuleBuilder) DestinationPort(start, end int) *FirewallRuleBuilder {
+	b.rule.DestinationPortStart = strconv.Itoa(start)
+	b.rule.DestinationPortEnd = strconv.Itoa(end)
+	return b
+}
+
+// Position sets the position of the rule in the server's firewall.
+func (b *FirewallRuleBuilder) Position(position int) *FirewallRuleBuilder {
+	b.rule.Position = position
+	return b
+}
+
+// Comment sets a freeform comment on the rule.
+func (b *FirewallRuleBuilder) Comment(comment string) *FirewallRuleBuilder {
+	b.rule.Comment = comment
+	return b
+}
+
+// Build validates and returns the rule.
+func (b *FirewallRuleBuilder) Build() (*FirewallRule, error) {
+	rule := b.rule
+	if err := rule.Validate(); err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+// FirewallRuleList represents the list of firewall rules
+type FirewallRuleList struct {
+	FirewallRules []FirewallRule `json:"firewall_rule"`
+}
+
+// FirewallRuleListResponse represents the request/response wrapper for the firewall rule list
+type FirewallRuleListResponse struct {
+	FirewallRuleList *FirewallRuleList `json:"firewall_rules"`
+}
+
+// FirewallRuleResponse represents the request/response wrapper for a single firewall rule
+type FirewallRuleResponse struct {
+	FirewallRule *FirewallRule `json:"firewall_rule"`
+}
+
+// ListFirewallRules returns the firewall rules of the server with the given uuid.
+// https://developers.upcloud.com/1.3/11-firewall/#list-firewall-rules
+func (s *FirewallService) ListFirewallRules(ctx context.Context, serverUUID string) (*FirewallRuleList, *http.Response, error) {
+	u := fmt.Sprintf("server/%v/firewall_rule", serverUUID)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ruleList := new(FirewallRuleList)
+	resp, err := s.client.Do(ctx, req, &FirewallRuleListResponse{FirewallRuleList: ruleList})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ruleList, resp, nil
+}
+
+// GetFirewallRule returns the firewall rule at position of the server with the given uuid.
+// https://developers.upcloud.com/1.3/11-firewall/#get-firewall-rule-details
+func (s *FirewallService) GetFirewallRule(ctx context.Context, serverUUID string, position int) (*FirewallRule, *http.Response, error) {
+	u := fmt.Sprintf("server/%v/firewall_rule/%v", serverUUID, position)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rule := new(FirewallRule)
+	resp, err := s.client.Do(ctx, req, &FirewallRuleResponse{FirewallRule: rule})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return rule, resp, nil
+}
+
+// CreateFirewallRule adds rule to the firewall of the server with the given uuid.
+// https://developers.upcloud.com/1.3/11-firewall/#create-firewall-rule
+func (s *FirewallService) CreateFirewallRule(ctx context.Context, serverUUID string, rule *FirewallRule) (*FirewallRule, *http.Response, error) {
+	if err := rule.Validate(); err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("server/%v/firewall_rule", serverUUID)
+	req, err := s.client.NewRequest("POST", u, &FirewallRuleResponse{FirewallRule: rule})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created := new(FirewallRule)
+	resp, err := s.client.Do(ctx, req, &FirewallRuleResponse{FirewallRule: created})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return created, resp, nil
+}
+
+// ReplaceFirewallRules replaces all firewall rules of the server with the given uuid with rules.
+// https://developers.upcloud.com/1.3/11-firewall/#create-firewall-rules-in-batch
+func (s *FirewallService) ReplaceFirewallRules(ctx context.Context, serverUUID string, rules []FirewallRule) (*http.Response, error) {
+	for i := range rules {
+		if err := rules[i].Validate(); err != nil {
+			return nil, fmt.Errorf("rule %d: %v", i, err)
+		}
+	}
+	u := fmt.Sprintf("server/%v/firewall_rule", serverUUID)
+	body := &FirewallRuleListResponse{FirewallRuleList: &FirewallRuleList{FirewallRules: rules}}
+	req, err := s.client.NewRequest("PUT", u, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// DeleteFirewallRule removes the firewall rule at position of the server with the given uuid.
+// https://developers.upcloud.com/1.3/11-firewall/#remove-firewall-rule
+func (s *FirewallService) DeleteFirewallRule(ctx context.Context, serverUUID string, position int) (*http.Response, error) {
+	u := fmt.Sprintf("server/%v/firewall_rule/%v", serverUUID, position)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,267 @@
+package upcloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// GatewaysService handles communication with the network gateway related methods of the UpCloud API
+// https://developers.upcloud.com/1.3/13-networks/#gateways
+type GatewaysService service
+
+// Gateway features
+const (
+	GatewayFeatureNAT = "nat"
+	GatewayFeatureVPN = "vpn"
+)
+
+// Gateway configured status
+const (
+	GatewayStatusStarted = "started"
+	GatewayStatusStopped = "stopped"
+)
+
+// GatewayPlan represents a gateway plan
+type GatewayPlan struct {
+	Name                     string   `json:"name"`
+	PerGatewayBandwidthMbps  int      `json:"per_gateway_bandwidth_mbps"`
+	PerGatewayMaxConnections int      `json:"per_gateway_max_connections"`
+	ServerNumber             int      `json:"server_number"`
+	SupportedFeatures        []string `json:"supported_features"`
+	VPNTunnelAmount          int      `json:"vpn_tunnel_amount"`
+}
+
+// GatewayRouter references a router a gateway is attached to
+type GatewayRouter struct {
+	UUID string `json:"uuid"`
+}
+
+// GatewayAddress represents a public address of a gateway
+type GatewayAddress struct {
+	Address string `json:"address"`
+	Name    string `json:"name"`
+}
+
+// GatewayRoute represents a route advertised over a gateway connection
+type GatewayRoute struct {
+	Name   string `json:"name"`
+	Type   string `json:"type"` //static
+	Static string `json:"static_network"`
+}
+
+// GatewayTunnel represents an IPsec tunnel of a gateway connection
+type GatewayTunnel struct {
+	Name         string `json:"name"`
+	LocalAddress struct {
+		Name string `json:"name"`
+	} `json:"local_address"`
+	RemoteAddress struct {
+		Address string `json:"address"`
+	} `json:"remote_address"`
+	OperationalState string    `json:"operational_state"`
+	CreatedAt        time.Time `json:"created_at"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+// GatewayConnection represents a connection of a gateway to a remote network
+type GatewayConnection struct {
+	UUID         string          `json:"uuid"`
+	Name         string          `json:"name"`
+	Type         string          `json:"type"` //ipsec
+	LocalRoutes  []GatewayRoute  `json:"local_routes"`
+	RemoteRoutes []GatewayRoute  `json:"remote_routes"`
+	Tunnels      []GatewayTunnel `json:"tunnels"`
+	CreatedAt    time.Time       `json:"created_at"`
+	UpdatedAt    time.Time       `json:"updated_at"`
+}
+
+// Gateway represents a network gateway attached to routers
+type Gateway struct {
+	UUID             string              `json:"uuid"`
+	Name             string              `json:"name"`
+	Zone             string              `json:"zone"`
+	Plan             string              `json:"plan"`
+	Features         []string            `json:"features"` //nat/vpn
+	Routers          []GatewayRouter     `json:"routers"`
+	Addresses        []GatewayAddress    `json:"addresses"`
+	Connections      []GatewayConnection `json:"connections"`
+	ConfiguredStatus string              `json:"configured_status"` //started/stopped
+	OperationalState string              `json:"operational_state"`
+	Labels           []Label             `json:"labels"`
+	CreatedAt        time.Time           `json:"created_at"`
+	UpdatedAt        time.Time           `json:"updated_at"`
+}
+
+// ListGatewayPlans returns the available gateway plans.
+// https://developers.upcloud.com/1.3/13-networks/#list-gateway-plans
+func (s *GatewaysService) ListGatewayPlans(ctx context.Context) ([]GatewayPlan, *http.Response, error) {
+	req, err := s.client.NewRequest("GET", "gateway/plans", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var plans []GatewayPlan
+	resp, err := s.client.Do(ctx, req, &plans)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return plans, resp, nil
+}
+
+// ListGateways returns the gateways on the account.
+// https://developers.upcloud.com/1.3/13-networks/#list-gateways
+func (s *GatewaysService) ListGateways(ctx context.Context) ([]Gateway, *http.Response, error) {
+	req, err := s.client.NewRequest("GET", "gateway", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var gateways []Gateway
+	resp, err := s.client.Do(ctx, req, &gateways)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return gateways, resp, nil
+}
+
+// GetGatewayDetails returns the gateway with the given uuid.
+// https://developers.upcloud.com/1.3/13-networks/#get-gateway-details
+func (s *GatewaysService) GetGatewayDetails(ctx context.Context, uuid string) (*Gateway, *http.Response, error) {
+	u := fmt.Sprintf("gateway/%v", uuid)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	gateway := new(Gateway)
+	resp, err := s.client.Do(ctx, req, gateway)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return gateway, resp, nil
+}
+
+// CreateGatewayRequest represents the properties of a new gateway
+type CreateGatewayRequest struct {
+	Name             string          `json:"name"`
+	Zone             string          `json:"zone"`
+	Plan             string          `json:"plan,omitempty"`
+	Features         []string        `json:"features"` //nat/vpn
+	Routers          []GatewayRouter `json:"routers"`
+	ConfiguredStatus string          `json:"configured_status,omitempty"` //started/stopped
+	Labels           []Label         `json:"labels,omitempty"`
+}
+
+// CreateGateway creates a new gateway with the properties defined in r.
+// https://developers.upcloud.com/1.3/13-networks/#create-gateway
+func (s *GatewaysService) CreateGateway(ctx context.Context, r *CreateGatewayRequest) (*Gateway, *http.Response, error) {
+	req, err := s.client.NewRequest("POST", "gateway", r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	gateway := new(Gateway)
+	resp, err := s.client.Do(ctx, req, gateway)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return gateway, resp, nil
+}
+
+// ModifyGatewayRequest represents the properties of a gateway to modify, empty values are left unchanged
+type ModifyGatewayRequest struct {
+	Name             string  `json:"name,omitempty"`
+	Plan             string  `json:"plan,omitempty"`
+	ConfiguredStatus string  `json:"configured_status,omitempty"` //started/stopped
+	Labels           []Label `json:"labels,omitempty"`
+}
+
+// ModifyGateway modifies the gateway with the given uuid using the properties defined in r.
+// https://developers.upcloud.com/1.3/13-networks/#modify-gateway
+func (s *GatewaysService) ModifyGateway(ctx context.Context, uuid string, r *ModifyGatewayRequest) (*Gateway, *http.Response, error) {
+	u := fmt.Sprintf("gateway/%v", uuid)
+	req, err := s.client.NewRequest("PATCH", u, r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	gateway := new(Gateway)
+	resp, err := s.client.Do(ctx, req, gateway)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return gateway, resp, nil
+}
+
+// DeleteGateway deletes the gateway with the given uuid.
+// https://developers.upcloud.com/1.3/13-networks/#delete-gateway
+func (s *GatewaysService) DeleteGateway(ctx context.Context, uuid string) (*http.Response, error) {
+	u := fmt.Sprintf("gateway/%v", uuid)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListGatewayConnections returns the connections of the gateway with the given uuid.
+// https://developers.upcloud.com/1.3/13-networks/#list-gateway-connections
+func (s *GatewaysService) ListGatewayConnections(ctx context.Context, uuid string) ([]GatewayConnection, *http.Response, error) {
+	u := fmt.Sprintf("gateway/%v/connections", uuid)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var connections []GatewayConnection
+	resp, err := s.client.Do(ctx, req, &connections)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return connections, resp, nil
+}
+
+// GetGatewayConnection returns the gateway connection with the given name.
+// https://developers.upcloud.com/1.3/13-networks/#get-gateway-connection-details
+func (s *GatewaysService) GetGatewayConnection(ctx context.Context, uuid string, name string) (*GatewayConnection, *http.Response, error) {
+	u := fmt.Sprintf("gateway/%v/connections/%v", uuid, name)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	connection := new(GatewayConnection)
+	resp, err := s.client.Do(ctx, req, connection)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return connection, resp, nil
+}
+
+// ListGatewayConnectionTunnels returns the tunnels of a gateway connection along with their operational state.
+// https://developers.upcloud.com/1.3/13-networks/#list-gateway-connection-tunnels
+func (s *GatewaysService) ListGatewayConnectionTunnels(ctx context.Context, uuid string, connection string) ([]GatewayTunnel, *http.Response, error) {
+	u := fmt.Sprintf("gateway/%v/connections/%v/tunnels", uuid, connection)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var tunnels []GatewayTunnel
+	resp, err := s.client.Do(ctx, req, &tunnels)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return tunnels, resp, nil
+}
@@ -0,0 +1,124 @@
+package upcloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// HostsService handles communication with the private cloud host related methods of the UpCloud API
+// https://developers.upcloud.com/1.3/14-hosts/
+type HostsService service
+
+// Host statistic names
+const (
+	HostStatCPUIdle    = "cpu_idle"
+	HostStatMemoryFree = "memory_free"
+)
+
+// HostStat represents a single statistic sample of a host
+type HostStat struct {
+	Name      string  `json:"name"`
+	Timestamp string  `json:"timestamp"`
+	Value     float64 `json:"value"`
+}
+
+// HostStatList represents the list of statistics of a host
+type HostStatList struct {
+	Stats []HostStat `json:"stat"`
+}
+
+// Host represents a private cloud host
+type Host struct {
+	ID             int          `json:"id"`
+	Description    string       `json:"description"`
+	Zone           string       `json:"zone"`
+	WindowsEnabled string       `json:"windows_enabled"` //yes/no
+	Stats          HostStatList `json:"stats"`
+}
+
+// Stat returns the statistic of the host with the given name.
+func (h *Host) Stat(name string) (HostStat, bool) {
+	for _, stat := range h.Stats.Stats {
+		if stat.Name == name {
+			return stat, true
+		}
+	}
+	return HostStat{}, false
+}
+
+// HostList represents the list of hosts
+type HostList struct {
+	Hosts []Host `json:"host"`
+}
+
+// HostListResponse represents the response from the ListHosts API call
+type HostListResponse struct {
+	HostList *HostList `json:"hosts"`
+}
+
+// HostDetailsResponse represents the response from host details API methods
+type HostDetailsResponse struct {
+	Host *Host `json:"host"`
+}
+
+// ListHosts returns the private cloud hosts available to the account.
+// https://developers.upcloud.com/1.3/14-hosts/#list-available-hosts
+func (s *HostsService) ListHosts(ctx context.Context) (*HostList, *http.Response, error) {
+	req, err := s.client.NewRequest("GET", "host", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	hostList := new(HostList)
+	resp, err := s.client.Do(ctx, req, &HostListResponse{HostList: hostList})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return hostList, resp, nil
+}
+
+// GetHostDetails returns the description and statistics of the host with the given id.
+// https://developers.upcloud.com/1.3/14-hosts/#get-host-details
+func (s *HostsService) GetHostDetails(ctx context.Context, id int) (*Host, *http.Response, error) {
+	u := fmt.Sprintf("host/%v", id)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	host := new(Host)
+	resp, err := s.client.Do(ctx, req, &HostDetailsResponse{Host: host})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return host, resp, nil
+}
+
+type modifyHostRequestWrapper struct {
+	Host struct {
+		Description string `json:"description"`
+	} `json:"host"`
+}
+
+// ModifyHost sets the description of the host with the given id.
+// https://developers.upcloud.com/1.3/14-hosts/#modify-host-details
+func (s *HostsService) ModifyHost(ctx context.Context, id int, description string) (*Host, *http.Response, error) {
+	u := fmt.Sprintf("host/%v", id)
+	body := new(modifyHostRequestWrapper)
+	body.Host.Description = description
+	req, err := s.client.NewRequest("PATCH", u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	host := new(Host)
+	resp, err := s.client.Do(ctx, req, &HostDetailsResponse{Host: host})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return host, resp, nil
+}
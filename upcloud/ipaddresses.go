@@ -0,0 +1,170 @@
+package upcloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// IPAddressService handles communication with the IP address related methods of the UpCloud API
+// https://developers.upcloud.com/1.3/10-ip-addresses/
+type IPAddressService service
+
+// IP address access types
+const (
+	IPAddressAccessPublic  = "public"
+	IPAddressAccessPrivate = "private"
+	IPAddressAccessUtility = "utility"
+)
+
+// IP address families
+const (
+	IPAddressFamilyIPv4 = "IPv4"
+	IPAddressFamilyIPv6 = "IPv6"
+)
+
+// IPAddress represents the properties of an IP address
+type IPAddress struct {
+	Access     string `json:"access"` //public/private/utility
+	Address    string `json:"address"`
+	Family     string `json:"family"`   //IPv4/IPv6
+	Floating   string `json:"floating"` //yes/no
+	MAC        string `json:"mac,omitempty"`
+	PartOfPlan string `json:"part_of_plan,omitempty"` //yes/no
+	PTRRecord  string `json:"ptr_record"`
+	Server     string `json:"server,omitempty"` //UUID of the server the address is attached to
+	Zone       string `json:"zone,omitempty"`
+}
+
+// IPAddressList represents the list of IP addresses
+type IPAddressList struct {
+	IPAddresses []IPAddress `json:"ip_address"`
+}
+
+// IPAddressListResponse represents the response from the ListIPAddresses API call
+type IPAddressListResponse struct {
+	IPAddressList *IPAddressList `json:"ip_addresses"`
+}
+
+// IPAddressDetailsResponse represents the response from IP address details API methods
+type IPAddressDetailsResponse struct {
+	IPAddress *IPAddress `json:"ip_address"`
+}
+
+// ListIPAddresses returns all IP addresses on the account.
+// https://developers.upcloud.com/1.3/10-ip-addresses/#list-ip-addresses
+func (s *IPAddressService) ListIPAddresses(ctx context.Context) (*IPAddressList, *http.Response, error) {
+	req, err := s.client.NewRequest("GET", "ip_address", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ipAddressList := new(IPAddressList)
+	resp, err := s.client.Do(ctx, req, &IPAddressListResponse{IPAddressList: ipAddressList})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ipAddressList, resp, nil
+}
+
+// GetIPAddressDetails returns the properties of the given IP address.
+// https://developers.upcloud.com/1.3/10-ip-addresses/#get-ip-address-details
+func (s *IPAddressService) GetIPAddressDetails(ctx context.Context, address string) (*IPAddress, *http.Response, error) {
+	u := fmt.Sprintf("ip_address/%v", address)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ipAddress := new(IPAddress)
+	resp, err := s.client.Do(ctx, req, &IPAddressDetailsResponse{IPAddress: ipAddress})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ipAddress, resp, nil
+}
+
+// AssignIPAddressRequest represents the properties of a new IP address.
+// Floating addresses are assigned by MAC, or by Zone to leave them detached.
+type AssignIPAddressRequest struct {
+	Access   string `json:"access,omitempty"`   //public/private/utility
+	Family   string `json:"family,omitempty"`   //IPv4/IPv6
+	Floating string `json:"floating,omitempty"` //yes/no
+	MAC      string `json:"mac,omitempty"`
+	Server   string `json:"server,omitempty"`
+	Zone     string `json:"zone,omitempty"`
+}
+
+type assignIPAddressRequestWrapper struct {
+	IPAddress *AssignIPAddressRequest `json:"ip_address"`
+}
+
+// AssignIPAddress assigns a new IP address with the properties defined in r.
+// https://developers.upcloud.com/1.3/10-ip-addresses/#assign-ip-address
+func (s *IPAddressService) AssignIPAddress(ctx context.Context, r *AssignIPAddressRequest) (*IPAddress, *http.Response, error) {
+	req, err := s.client.NewRequest("POST", "ip_address", &assignIPAddressRequestWrapper{IPAddress: r})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ipAddress := new(IPAddress)
+	resp, err := s.client.Do(ctx, req, &IPAddressDetailsResponse{IPAddress: ipAddress})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ipAddress, resp, nil
+}
+
+// ModifyIPAddressRequest represents the properties of an IP address to modify
+type ModifyIPAddressRequest struct {
+	PTRRecord string `json:"ptr_record,omitempty"`
+	MAC       string `json:"mac,omitempty"` //Only for floating addresses
+}
+
+type modifyIPAddressRequestWrapper struct {
+	IPAddress *ModifyIPAddressRequest `json:"ip_address"`
+}
+
+// ModifyIPAddress modifies the given IP address using the properties defined in r.
+// https://developers.upcloud.com/1.3/10-ip-addresses/#modify-ip-address
+func (s *IPAddressService) ModifyIPAddress(ctx context.Context, address string, r *ModifyIPAddressRequest) (*IPAddress, *http.Response, error) {
+	u := fmt.Sprintf("ip_address/%v", address)
+	req, err := s.client.NewRequest("PUT", u, &modifyIPAddressRequestWrapper{IPAddress: r})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ipAddress := new(IPAddress)
+	resp, err := s.client.Do(ctx, req, &IPAddressDetailsResponse{IPAddress: ipAddress})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ipAddress, resp, nil
+}
+
+// ModifyPTRRecord sets the reverse DNS name of the given IP address.
+func (s *IPAddressService) ModifyPTRRecord(ctx context.Context, address string, ptrRecord string) (*IPAddress, *http.Response, error) {
+	return s.ModifyIPAddress(ctx, address, &ModifyIPAddressRequest{PTRRecord: ptrRecord})
+}
+
+// MoveFloatingIPAddress attaches the given floating IP address to the network interface with the given MAC address.
+// https://developers.upcloud.com/1.3/10-ip-addresses/#modify-ip-address
+func (s *IPAddressService) MoveFloatingIPAddress(ctx context.Context, address string, mac string) (*IPAddress, *http.Response, error) {
+	return s.ModifyIPAddress(ctx, address, &ModifyIPAddressRequest{MAC: mac})
+}
+
+// ReleaseIPAddress removes the given IP address from the account.
+// https://developers.upcloud.com/1.3/10-ip-addresses/#release-ip-address
+func (s *IPAddressService) ReleaseIPAddress(ctx context.Context, address string) (*http.Response, error) {
+	u := fmt.Sprintf("ip_address/%v", address)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,334 @@
+package upcloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// KubernetesService handles communication with the managed Kubernetes related methods of the UpCloud API
+// https://developers.upcloud.com/1.3/19-managed-kubernetes/
+type KubernetesService service
+
+// Kubernetes cluster and node group states
+const (
+	KubernetesStatePending     = "pending"
+	KubernetesStateRunning     = "running"
+	KubernetesStateTerminating = "terminating"
+	KubernetesStateFailed      = "failed"
+)
+
+// Kubernetes taint effects
+const (
+	KubernetesTaintNoSchedule       = "NoSchedule"
+	KubernetesTaintPreferNoSchedule = "PreferNoSchedule"
+	KubernetesTaintNoExecute        = "NoExecute"
+)
+
+// KubernetesVersion represents a Kubernetes version clusters can be created with
+type KubernetesVersion struct {
+	ID      string `json:"id"`
+	Version string `json:"version"`
+}
+
+// KubernetesPlan represents a control plane plan of a cluster
+type KubernetesPlan struct {
+	Name         string `json:"name"`
+	ServerNumber int    `json:"server_number"`
+	MaxNodes     int    `json:"max_nodes"`
+}
+
+// KubernetesTaint represents a taint applied to the nodes of a node group
+type KubernetesTaint struct {
+	Key    string `json:"key"`
+	Value  string `json:"value"`
+	Effect string `json:"effect"` //NoSchedule/PreferNoSchedule/NoExecute
+}
+
+// KubeletArg represents an argument passed to the kubelet of the nodes of a node group
+type KubeletArg struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// KubernetesNodeGroup represents a group of worker nodes with the same configuration
+type KubernetesNodeGroup struct {
+	Name         string            `json:"name"`
+	Count        int               `json:"count"`
+	Plan         string            `json:"plan"`
+	Labels       []Label           `json:"labels,omitempty"`
+	Taints       []KubernetesTaint `json:"taints,omitempty"`
+	SSHKeys      []string          `json:"ssh_keys,omitempty"`
+	Storage      string            `json:"storage,omitempty"` //Template UUID, defaults to the UpCloud node image
+	KubeletArgs  []KubeletArg      `json:"kubelet_args,omitempty"`
+	AntiAffinity bool              `json:"anti_affinity,omitempty"`
+	State        string            `json:"state,omitempty"`
+}
+
+// KubernetesCluster represents a managed Kubernetes cluster
+type KubernetesCluster struct {
+	UUID                 string                `json:"uuid"`
+	Name                 string                `json:"name"`
+	Network              string                `json:"network"` //SDN network UUID
+	NetworkCIDR          string                `json:"network_cidr"`
+	Zone                 string                `json:"zone"`
+	Version              string                `json:"version"`
+	Plan                 string                `json:"plan"`
+	State                string                `json:"state"`
+	ControlPlaneIPFilter []string              `json:"control_plane_ip_filter"`
+	NodeGroups           []KubernetesNodeGroup `json:"node_groups"`
+	Labels               []Label               `json:"labels"`
+}
+
+// ListKubernetesVersions returns the Kubernetes versions available for new clusters.
+// https://developers.upcloud.com/1.3/19-managed-kubernetes/#list-available-versions
+func (s *KubernetesService) ListKubernetesVersions(ctx context.Context) ([]KubernetesVersion, *http.Response, error) {
+	req, err := s.client.NewRequest("GET", "kubernetes/versions", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var versions []KubernetesVersion
+	resp, err := s.client.Do(ctx, req, &versions)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return versions, resp, nil
+}
+
+// ListKubernetesPlans returns the control plane plans available for new clusters.
+// https://developers.upcloud.com/1.3/19-managed-kubernetes/#list-plans
+func (s *KubernetesService) ListKubernetesPlans(ctx context.Context) ([]KubernetesPlan, *http.Response, error) {
+	req, err := s.client.NewRequest("GET", "kubernetes/plans", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var plans []KubernetesPlan
+	resp, err := s.client.Do(ctx, req, &plans)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return plans, resp, nil
+}
+
+// ListKubernetesClusters returns the Kubernetes clusters on the account.
+// https://developers.upcloud.com/1.3/19-managed-kubernetes/#list-clusters
+func (s *KubernetesService) ListKubernetesClusters(ctx context.Context) ([]KubernetesCluster, *http.Response, error) {
+	req, err := s.client.NewRequest("GET", "kubernetes", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var clusters []KubernetesCluster
+	resp, err := s.client.Do(ctx, req, &clusters)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return clusters, resp, nil
+}
+
+// GetKubernetesCluster returns the Kubernetes cluster with the given uuid.
+// https://developers.upcloud.com/1.3/19-managed-kubernetes/#get-cluster-details
+func (s *KubernetesService) GetKubernetesCluster(ctx context.Context, uuid string) (*KubernetesCluster, *http.Response, error) {
+	u := fmt.Sprintf("kubernetes/%v", uuid)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cluster := new(KubernetesCluster)
+	resp, err := s.client.Do(ctx, req, cluster)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return cluster, resp, nil
+}
+
+// CreateKubernetesClusterRequest represents the properties of a new Kubernetes cluster.
+// Network must be an SDN private network in Zone whose IP network matches NetworkCIDR.
+type CreateKubernetesClusterRequest struct {
+	Name                 string                `json:"name"`
+	Network              string                `json:"network"`
+	NetworkCIDR          string                `json:"network_cidr"`
+	Zone                 string                `json:"zone"`
+	Version              string                `json:"version,omitempty"`
+	Plan                 string                `json:"plan,omitempty"`
+	ControlPlaneIPFilter []string              `json:"control_plane_ip_filter"`
+	NodeGroups           []KubernetesNodeGroup `json:"node_groups"`
+	Labels               []Label               `json:"labels,omitempty"`
+}
+
+// CreateKubernetesCluster creates a new Kubernetes cluster with the properties defined in r.
+// https://developers.upcloud.com/1.3/19-managed-kubernetes/#create-cluster
+func (s *KubernetesService) CreateKubernetesCluster(ctx context.Context, r *CreateKubernetesClusterRequest) (*KubernetesCluster, *http.Response, error) {
+	// The API expects arrays, send nil slices as [] rather than null
+	body := *r
+	if body.ControlPlaneIPFilter == nil {
+		body.ControlPlaneIPFilter = []string{}
+	}
+	if body.NodeGroups == nil {
+		body.NodeGroups = []KubernetesNodeGroup{}
+	}
+	req, err := s.client.NewRequest("POST", "kubernetes", &body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	cluster := new(KubernetesCluster)
+	resp, err := s.client.Do(ctx, req, cluster)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return cluster, resp, nil
+}
+
+// DeleteKubernetesCluster deletes the Kubernetes cluster with the given uuid and all its nodes.
+// https://developers.upcloud.com/1.3/19-managed-kubernetes/#delete-cluster
+func (s *KubernetesService) DeleteKubernetesCluster(ctx context.Context, uuid string) (*http.Response, error) {
+	u := fmt.Sprintf("kubernetes/%v", uuid)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// WaitForKubernetesClusterState polls the Kubernetes cluster with the given uuid until it reaches state or ctx expires.
+// An error is returned if the cluster fails while waiting for another state.
+func (s *KubernetesService) WaitForKubernetesClusterState(ctx context.Context, uuid, state string) (*KubernetesCluster, *http.Response, error) {
+	var cluster *KubernetesCluster
+	var resp *http.Response
+	err := poll(ctx, func() (bool, error) {
+		var err error
+		cluster, resp, err = s.GetKubernetesCluster(ctx, uuid)
+		if err != nil {
+			return false, err
+		}
+		if cluster.State == state {
+			return true, nil
+		}
+		if cluster.State == KubernetesStateFailed {
+			return false, fmt.Errorf("kubernetes cluster %v entered %v state while waiting for %v", uuid, KubernetesStateFailed, state)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return cluster, resp, nil
+}
+
+// GetKubernetesKubeconfig returns the kubeconfig for accessing the Kubernetes cluster with the given uuid.
+// https://developers.upcloud.com/1.3/19-managed-kubernetes/#get-kubeconfig
+func (s *KubernetesService) GetKubernetesKubeconfig(ctx context.Context, uuid string) (string, *http.Response, error) {
+	u := fmt.Sprintf("kubernetes/%v/kubeconfig", uuid)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return "", nil, err
+	}
+
+	kubeconfig := new(struct {
+		Kubeconfig string `json:"kubeconfig"`
+	})
+	resp, err := s.client.Do(ctx, req, kubeconfig)
+	if err != nil {
+		return "", resp, err
+	}
+
+	return kubeconfig.Kubeconfig, resp, nil
+}
+
+// ListKubernetesNodeGroups returns the node groups of the Kubernetes cluster with the given uuid.
+// https://developers.upcloud.com/1.3/19-managed-kubernetes/#list-node-groups
+func (s *KubernetesService) ListKubernetesNodeGroups(ctx context.Context, uuid string) ([]KubernetesNodeGroup, *http.Response, error) {
+	u := fmt.Sprintf("kubernetes/%v/node-groups", uuid)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var nodeGroups []KubernetesNodeGroup
+	resp, err := s.client.Do(ctx, req, &nodeGroups)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return nodeGroups, resp, nil
+}
+
+// GetKubernetesNodeGroup returns the node group with the given name.
+// https://developers.upcloud.com/1.3/19-managed-kubernetes/#get-node-group-details
+func (s *KubernetesService) GetKubernetesNodeGroup(ctx context.Context, uuid string, name string) (*KubernetesNodeGroup, *http.Response, error) {
+	u := fmt.Sprintf("kubernetes/%v/node-groups/%v", uuid, name)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	nodeGroup := new(KubernetesNodeGroup)
+	resp, err := s.client.Do(ctx, req, nodeGroup)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return nodeGroup, resp, nil
+}
+
+// CreateKubernetesNodeGroup adds a node group to the Kubernetes cluster with the given uuid.
+// https://developers.upcloud.com/1.3/19-managed-kubernetes/#create-node-group
+func (s *KubernetesService) CreateKubernetesNodeGroup(ctx context.Context, uuid string, nodeGroup *KubernetesNodeGroup) (*KubernetesNodeGroup, *http.Response, error) {
+	u := fmt.Sprintf("kubernetes/%v/node-groups", uuid)
+	req, err := s.client.NewRequest("POST", u, nodeGroup)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created := new(KubernetesNodeGroup)
+	resp, err := s.client.Do(ctx, req, created)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return created, resp, nil
+}
+
+// ScaleKubernetesNodeGroup sets the number of nodes in the node group with the given name.
+// https://developers.upcloud.com/1.3/19-managed-kubernetes/#modify-node-group
+func (s *KubernetesService) ScaleKubernetesNodeGroup(ctx context.Context, uuid string, name string, count int) (*KubernetesNodeGroup, *http.Response, error) {
+	u := fmt.Sprintf("kubernetes/%v/node-groups/%v", uuid, name)
+	body := &struct {
+		Count int `json:"count"`
+	}{count}
+	req, err := s.client.NewRequest("PATCH", u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	nodeGroup := new(KubernetesNodeGroup)
+	resp, err := s.client.Do(ctx, req, nodeGroup)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return nodeGroup, resp, nil
+}
+
+// DeleteKubernetesNodeGroup removes the node group with the given name and its nodes.
+// https://developers.upcloud.com/1.3/19-managed-kubernetes/#delete-node-group
+func (s *KubernetesService) DeleteKubernetesNodeGroup(ctx context.Context, uuid string, name string) (*http.Response, error) {
+	u := fmt.Sprintf("kubernetes/%v/node-groups/%v", uuid, name)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,1214 @@
+package upcloud
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// LoadBalancerService handles communication with the managed load balancer related methods of the UpCloud API
+// https://developers.upcloud.com/1.3/17-managed-loadbalancer/
+type LoadBalancerService service
+
+// Load balancer configured status
+const (
+	LoadBalancerStatusStarted = "started"
+	LoadBalancerStatusStopped = "stopped"
+)
+
+// Frontend modes
+const (
+	LoadBalancerModeHTTP = "http"
+	LoadBalancerModeTCP  = "tcp"
+)
+
+// Backend member types
+const (
+	LoadBalancerMemberTypeStatic  = "static"
+	LoadBalancerMemberTypeDynamic = "dynamic"
+)
+
+// Health check types
+const (
+	LoadBalancerHealthCheckTCP  = "tcp"
+	LoadBalancerHealthCheckHTTP = "http"
+)
+
+// Frontend rule matcher types
+const (
+	LoadBalancerMatcherTypeSrcIP      = "src_ip"
+	LoadBalancerMatcherTypeSrcPort    = "src_port"
+	LoadBalancerMatcherTypeBodySize   = "body_size"
+	LoadBalancerMatcherTypePath       = "path"
+	LoadBalancerMatcherTypeURL        = "url"
+	LoadBalancerMatcherTypeURLQuery   = "url_query"
+	LoadBalancerMatcherTypeHost       = "host"
+	LoadBalancerMatcherTypeHTTPMethod = "http_method"
+	LoadBalancerMatcherTypeCookie     = "cookie"
+	LoadBalancerMatcherTypeHeader     = "header"
+	LoadBalancerMatcherTypeURLParam   = "url_param"
+)
+
+// String matcher methods
+const (
+	LoadBalancerStringMatcherExact     = "exact"
+	LoadBalancerStringMatcherSubstring = "substring"
+	LoadBalancerStringMatcherRegexp    = "regexp"
+	LoadBalancerStringMatcherStarts    = "starts"
+	LoadBalancerStringMatcherEnds      = "ends"
+)
+
+// Integer matcher methods
+const (
+	LoadBalancerIntegerMatcherEqual          = "equal"
+	LoadBalancerIntegerMatcherGreater        = "greater"
+	LoadBalancerIntegerMatcherGreaterOrEqual = "greater_or_equal"
+	LoadBalancerIntegerMatcherLess           = "less"
+	LoadBalancerIntegerMatcherLessOrEqual    = "less_or_equal"
+	LoadBalancerIntegerMatcherRange          = "range"
+)
+
+// Frontend rule action types
+const (
+	LoadBalancerActionTypeUseBackend   = "use_backend"
+	LoadBalancerActionTypeHTTPReturn   = "http_return"
+	LoadBalancerActionTypeHTTPRedirect = "http_redirect"
+	LoadBalancerActionTypeTCPReject    = "tcp_reject"
+)
+
+// Certificate bundle types
+const (
+	LoadBalancerCertificateBundleManual    = "manual"
+	LoadBalancerCertificateBundleDynamic   = "dynamic"
+	LoadBalancerCertificateBundleAuthority = "authority"
+)
+
+// LoadBalancerPlan represents a load balancer plan
+type LoadBalancerPlan struct {
+	Name                 string `json:"name"`
+	ServerNumber         int    `json:"server_number"`
+	PerServerMaxSessions int    `json:"per_server_max_sessions"`
+}
+
+// LoadBalancerNetwork represents a network a load balancer is attached to
+type LoadBalancerNetwork struct {
+	Name    string `json:"name"`
+	Type    string `json:"type"`           //public/private
+	Family  string `json:"family"`         //IPv4
+	UUID    string `json:"uuid,omitempty"` //SDN network UUID, only for private networks
+	DNSName string `json:"dns_name,omitempty"`
+}
+
+// LoadBalancerFrontendNetwork references a load balancer network a frontend listens on
+type LoadBalancerFrontendNetwork struct {
+	Name string `json:"name"`
+}
+
+// LoadBalancerFrontendProperties represents the optional properties of a frontend
+type LoadBalancerFrontendProperties struct {
+	TimeoutClient        int   `json:"timeout_client,omitempty"` //Seconds
+	InboundProxyProtocol *bool `json:"inbound_proxy_protocol,omitempty"`
+}
+
+// LoadBalancerTLSConfig attaches a certificate bundle to a frontend
+type LoadBalancerTLSConfig struct {
+	Name                  string `json:"name"`
+	CertificateBundleUUID string `json:"certificate_bundle_uuid"`
+}
+
+// LoadBalancerMatcherValue represents a matcher that compares a single value
+type LoadBalancerMatcherValue struct {
+	Value string `json:"value"`
+}
+
+// LoadBalancerMatcherString represents a matcher that compares strings
+type LoadBalancerMatcherString struct {
+	Method     string `json:"method"`         //exact/substring/regexp/starts/ends
+	Name       string `json:"name,omitempty"` //Cookie, header or URL parameter name
+	Value      string `json:"value,omitempty"`
+	IgnoreCase *bool  `json:"ignore_case,omitempty"`
+}
+
+// LoadBalancerMatcherInteger represents a matcher that compares integers
+type LoadBalancerMatcherInteger struct {
+	Method   string `json:"method"` //equal/greater/greater_or_equal/less/less_or_equal/range
+	Value    int    `json:"value"`
+	RangeEnd int    `json:"range_end,omitempty"` //Only for range
+}
+
+// LoadBalancerMatcher represents a frontend rule matcher, only the field for Type is set
+type LoadBalancerMatcher struct {
+	Type            string                      `json:"type"`
+	Inverse         *bool                       `json:"inverse,omitempty"`
+	MatchSrcIP      *LoadBalancerMatcherValue   `json:"match_src_ip,omitempty"`
+	MatchSrcPort    *LoadBalancerMatcherInteger `json:"match_src_port,omitempty"`
+	MatchBodySize   *LoadBalancerMatcherInteger `json:"match_body_size,omitempty"`
+	MatchPath       *LoadBalancerMatcherString  `json:"match_path,omitempty"`
+	MatchURL        *LoadBalancerMatcherString  `json:"match_url,omitempty"`
+	MatchURLQuery   *LoadBalancerMatcherString  `json:"match_url_query,omitempty"`
+	MatchHost       *LoadBalancerMatcherValue   `json:"match_host,omitempty"`
+	MatchHTTPMethod *LoadBalancerMatcherValue   `json:"match_http_method,omitempty"`
+	MatchCookie     *LoadBalancerMatcherString  `json:"match_cookie,omitempty"`
+	MatchHeader     *LoadBalancerMatcherString  `json:"match_header,omitempty"`
+	MatchURLParam   *LoadBalancerMatcherString  `json:"match_url_param,omitempty"`
+}
+
+// LoadBalancerActionUseBackend routes matching requests to a backend
+type LoadBalancerActionUseBackend struct {
+	Backend string `json:"backend"`
+}
+
+// LoadBalancerActionHTTPReturn answers matching requests directly
+type LoadBalancerActionHTTPReturn struct {
+	Status      int    `json:"status"`
+	ContentType string `json:"content_type"`
+	Payload     string `json:"payload"` //Base64 encoded
+}
+
+// LoadBalancerActionHTTPRedirect redirects matching requests
+type LoadBalancerActionHTTPRedirect struct {
+	Location string `json:"location"`
+}
+
+// LoadBalancerActionTCPReject rejects matching connections
+type LoadBalancerActionTCPReject struct{}
+
+// LoadBalancerAction represents a frontend rule action, only the field for Type is set
+type LoadBalancerAction struct {
+	Type               string                          `json:"type"`
+	ActionUseBackend   *LoadBalancerActionUseBackend   `json:"action_use_backend,omitempty"`
+	ActionHTTPReturn   *LoadBalancerActionHTTPReturn   `json:"action_http_return,omitempty"`
+	ActionHTTPRedirect *LoadBalancerActionHTTPRedirect `json:"action_http_redirect,omitempty"`
+	ActionTCPReject    *LoadBalancerActionTCPReject    `json:"action_tcp_reject,omitempty"`
+}
+
+// LoadBalancerRule represents a frontend rule, actions are taken when all matchers match
+type LoadBalancerRule struct {
+	Name     string                `json:"name,omitempty"`
+	Priority int                   `json:"priority,omitempty"` //0-100, higher is evaluated first
+	Matchers []LoadBalancerMatcher `json:"matchers,omitempty"`
+	Actions  []LoadBalancerAction  `json:"actions,omitempty"`
+}
+
+// LoadBalancerFrontend represents a frontend of a load balancer
+type LoadBalancerFrontend struct {
+	Name           string                          `json:"name,omitempty"`
+	Mode           string                          `json:"mode,omitempty"` //http/tcp
+	Port           int                             `json:"port,omitempty"`
+	DefaultBackend string                          `json:"default_backend,omitempty"`
+	Networks       []LoadBalancerFrontendNetwork   `json:"networks,omitempty"`
+	Rules          []LoadBalancerRule              `json:"rules,omitempty"`
+	TLSConfigs     []LoadBalancerTLSConfig         `json:"tls_configs,omitempty"`
+	Properties     *LoadBalancerFrontendProperties `json:"properties,omitempty"`
+}
+
+// LoadBalancerBackendProperties represents the optional properties of a backend
+type LoadBalancerBackendProperties struct {
+	TimeoutServer             int    `json:"timeout_server,omitempty"`        //Seconds
+	TimeoutTunnel             int    `json:"timeout_tunnel,omitempty"`        //Seconds
+	HealthCheckType           string `json:"health_check_type,omitempty"`     //tcp/http
+	HealthCheckInterval       int    `json:"health_check_interval,omitempty"` //Seconds
+	HealthCheckFall           int    `json:"health_check_fall,omitempty"`
+	HealthCheckRise           int    `json:"health_check_rise,omitempty"`
+	HealthCheckURL            string `json:"health_check_url,omitempty"`
+	HealthCheckExpectedStatus int    `json:"health_check_expected_status,omitempty"`
+	StickySessionCookieName   string `json:"sticky_session_cookie_name,omitempty"`
+	OutboundProxyProtocol     string `json:"outbound_proxy_protocol,omitempty"` //v2
+}
+
+// LoadBalancerBackendMember represents a server traffic is balanced to
+type LoadBalancerBackendMember struct {
+	Name        string `json:"name,omitempty"`
+	Type        string `json:"type,omitempty"` //static/dynamic
+	IP          string `json:"ip,omitempty"`
+	Port        int    `json:"port,omitempty"`
+	Weight      *int   `json:"weight,omitempty"` //0-100, nil uses the API default and 0 drains the member
+	MaxSessions int    `json:"max_sessions,omitempty"`
+	Enabled     *bool  `json:"enabled,omitempty"`
+}
+
+// LoadBalancerBackend represents a backend of a load balancer
+type LoadBalancerBackend struct {
+	Name       string                         `json:"name,omitempty"`
+	Resolver   string                         `json:"resolver,omitempty"` //Required for dynamic members
+	Members    []LoadBalancerBackendMember    `json:"members,omitempty"`
+	Properties *LoadBalancerBackendProperties `json:"properties,omitempty"`
+}
+
+// LoadBalancerResolver represents a DNS resolver used by dynamic backend members
+type LoadBalancerResolver struct {
+	Name         string   `json:"name,omitempty"`
+	Nameservers  []string `json:"nameservers,omitempty"` //ip:port
+	Retries      int      `json:"retries,omitempty"`
+	Timeout      int      `json:"timeout,omitempty"`       //Seconds
+	TimeoutRetry int      `json:"timeout_retry,omitempty"` //Seconds
+	CacheValid   int      `json:"cache_valid,omitempty"`   //Seconds
+	CacheInvalid int      `json:"cache_invalid,omitempty"` //Seconds
+}
+
+// LoadBalancer represents a managed load balancer
+type LoadBalancer struct {
+	UUID             string                 `json:"uuid"`
+	Name             string                 `json:"name"`
+	Zone             string                 `json:"zone"`
+	Plan             string                 `json:"plan"`
+	ConfiguredStatus string                 `json:"configured_status"` //started/stopped
+	OperationalState string                 `json:"operational_state"`
+	DNSName          string                 `json:"dns_name,omitempty"`
+	Networks         []LoadBalancerNetwork  `json:"networks"`
+	Frontends        []LoadBalancerFrontend `json:"frontends"`
+	Backends         []LoadBalancerBackend  `json:"backends"`
+	Resolvers        []LoadBalancerResolver `json:"resolvers"`
+	Labels           []Label                `json:"labels"`
+	CreatedAt        time.Time              `json:"created_at"`
+	UpdatedAt        time.Time              `json:"updated_at"`
+}
+
+// LoadBalancerCertificateBundle represents a TLS certificate bundle usable by frontends.
+// Certificate, Intermediates and PrivateKey are base64 encoded PEM.
+type LoadBalancerCertificateBundle struct {
+	UUID             string     `json:"uuid,omitempty"`
+	Name             string     `json:"name,omitempty"`
+	Type             string     `json:"type,omitempty"` //manual/dynamic/authority
+	Certificate      string     `json:"certificate,omitempty"`
+	Intermediates    string     `json:"intermediates,omitempty"`
+	PrivateKey       string     `json:"private_key,omitempty"` //Never returned
+	Hostnames        []string   `json:"hostnames,omitempty"`   //Only for dynamic bundles
+	KeyType          string     `json:"key_type,omitempty"`    //Only for dynamic bundles, rsa/ecdsa
+	OperationalState string     `json:"operational_state,omitempty"`
+	NotAfter         *time.Time `json:"not_after,omitempty"`
+	NotBefore        *time.Time `json:"not_before,omitempty"`
+}
+
+// Validate reports whether the matcher has the value for its type set.
+func (m *LoadBalancerMatcher) Validate() error {
+	var set bool
+	switch m.Type {
+	case LoadBalancerMatcherTypeSrcIP:
+		set = m.MatchSrcIP != nil
+	case LoadBalancerMatcherTypeSrcPort:
+		set = m.MatchSrcPort != nil
+	case LoadBalancerMatcherTypeBodySize:
+		set = m.MatchBodySize != nil
+	case LoadBalancerMatcherTypePath:
+		set = m.MatchPath != nil
+	case LoadBalancerMatcherTypeURL:
+		set = m.MatchURL != nil
+	case LoadBalancerMatcherTypeURLQuery:
+		set = m.MatchURLQuery != nil
+	case LoadBalancerMatcherTypeHost:
+		set = m.MatchHost != nil
+	case LoadBalancerMatcherTypeHTTPMethod:
+		set = m.MatchHTTPMethod != nil
+	case LoadBalancerMatcherTypeCookie:
+		set = m.MatchCookie != nil && m.MatchCookie.Name != ""
+	case LoadBalancerMatcherTypeHeader:
+		set = m.MatchHeader != nil && m.MatchHeader.Name != ""
+	case LoadBalancerMatcherTypeURLParam:
+		set = m.MatchURLParam != nil && m.MatchURLParam.Name != ""
+	default:
+		return fmt.Errorf("matcher type %q is invalid", m.Type)
+	}
+	if !set {
+		return fmt.Errorf("matcher of type %q requires match_%v", m.Type, m.Type)
+	}
+	return nil
+}
+
+// Validate reports whether the action has the value for its type set.
+func (a *LoadBalancerAction) Validate() error {
+	var set bool
+	switch a.Type {
+	case LoadBalancerActionTypeUseBackend:
+		set = a.ActionUseBackend != nil && a.ActionUseBackend.Backend != ""
+	case LoadBalancerActionTypeHTTPReturn:
+		set = a.ActionHTTPReturn != nil
+	case LoadBalancerActionTypeHTTPRedirect:
+		set = a.ActionHTTPRedirect != nil && a.ActionHTTPRedirect.Location != ""
+	case LoadBalancerActionTypeTCPReject:
+		set = a.ActionTCPReject != nil
+	default:
+		return fmt.Errorf("action type %q is invalid", a.Type)
+	}
+	if !set {
+		return fmt.Errorf("action of type %q requires action_%v", a.Type, a.Type)
+	}
+	return nil
+}
+
+// Validate reports whether the rule and its matchers and actions are complete.
+func (r *LoadBalancerRule) Validate() error {
+	if r.Name == "" {
+		return errors.New("rule name is required")
+	}
+	if r.Priority < 0 || r.Priority > 100 {
+		return fmt.Errorf("rule %v priority %d must be between 0 and 100", r.Name, r.Priority)
+	}
+	if len(r.Actions) == 0 {
+		return fmt.Errorf("rule %v requires at least one action", r.Name)
+	}
+	for i := range r.Matchers {
+		if err := r.Matchers[i].Validate(); err != nil {
+			return fmt.Errorf("rule %v: %v", r.Name, err)
+		}
+	}
+	for i := range r.Actions {
+		if err := r.Actions[i].Validate(); err != nil {
+			return fmt.Errorf("rule %v: %v", r.Name, err)
+		}
+	}
+	return nil
+}
+
+// Validate reports whether the frontend and its rules are complete.
+func (f *LoadBalancerFrontend) Validate() error {
+	if f.Name == "" {
+		return errors.New("frontend name is required")
+	}
+	if f.Mode != LoadBalancerModeHTTP && f.Mode != LoadBalancerModeTCP {
+		return fmt.Errorf("frontend %v mode %q is invalid", f.Name, f.Mode)
+	}
+	if f.Port < 1 || f.Port > 65535 {
+		return fmt.Errorf("frontend %v port %d must be between 1 and 65535", f.Name, f.Port)
+	}
+	if f.DefaultBackend == "" {
+		return fmt.Errorf("frontend %v default backend is required", f.Name)
+	}
+	for i := range f.Rules {
+		if err := f.Rules[i].Validate(); err != nil {
+			return fmt.Errorf("frontend %v: %v", f.Name, err)
+		}
+	}
+	return nil
+}
+
+// Validate reports whether the member is complete.
+func (m *LoadBalancerBackendMember) Validate() error {
+	if m.Name == "" {
+		return errors.New("member name is required")
+	}
+	switch m.Type {
+	case LoadBalancerMemberTypeStatic:
+		if m.IP == "" || m.Port < 1 || m.Port > 65535 {
+			return fmt.Errorf("static member %v requires an ip and a port between 1 and 65535", m.Name)
+		}
+	case LoadBalancerMemberTypeDynamic:
+	default:
+		return fmt.Errorf("member %v type %q is invalid", m.Name, m.Type)
+	}
+	if m.Weight != nil && (*m.Weight < 0 || *m.Weight > 100) {
+		return fmt.Errorf("member %v weight %d must be between 0 and 100", m.Name, *m.Weight)
+	}
+	return nil
+}
+
+// Validate reports whether the backend and its members are complete.
+func (b *LoadBalancerBackend) Validate() error {
+	if b.Name == "" {
+		return errors.New("backend name is required")
+	}
+	for i := range b.Members {
+		if err := b.Members[i].Validate(); err != nil {
+			return fmt.Errorf("backend %v: %v", b.Name, err)
+		}
+		if b.Members[i].Type == LoadBalancerMemberTypeDynamic && b.Resolver == "" {
+			return fmt.Errorf("backend %v requires a resolver for dynamic members", b.Name)
+		}
+	}
+	return nil
+}
+
+// CreateLoadBalancerRequest represents the properties of a new load balancer
+type CreateLoadBalancerRequest struct {
+	Name             string                 `json:"name"`
+	Plan             string                 `json:"plan"`
+	Zone             string                 `json:"zone"`
+	ConfiguredStatus string                 `json:"configured_status"` //started/stopped
+	Networks         []LoadBalancerNetwork  `json:"networks"`
+	Frontends        []LoadBalancerFrontend `json:"frontends"`
+	Backends         []LoadBalancerBackend  `json:"backends"`
+	Resolvers        []LoadBalancerResolver `json:"resolvers"`
+	Labels           []Label                `json:"labels,omitempty"`
+}
+
+// Validate reports whether the load balancer is complete and all names it references exist.
+func (r *CreateLoadBalancerRequest) Validate() error {
+	if r.Name == "" || r.Plan == "" || r.Zone == "" {
+		return errors.New("load balancer name, plan and zone are required")
+	}
+	if len(r.Networks) == 0 {
+		return errors.New("load balancer requires at least one network")
+	}
+
+	networks := make(map[string]bool)
+	for _, n := range r.Networks {
+		if n.Type == NetworkTypePrivate && n.UUID == "" {
+			return fmt.Errorf("private network %v requires a uuid", n.Name)
+		}
+		networks[n.Name] = true
+	}
+
+	resolvers := make(map[string]bool)
+	for _, res := range r.Resolvers {
+		if res.Name == "" || len(res.Nameservers) == 0 {
+			return errors.New("resolver requires a name and at least one nameserver")
+		}
+		resolvers[res.Name] = true
+	}
+
+	backends := make(map[string]bool)
+	for i := range r.Backends {
+		b := &r.Backends[i]
+		if err := b.Validate(); err != nil {
+			return err
+		}
+		if backends[b.Name] {
+			return fmt.Errorf("backend %v is defined more than once", b.Name)
+		}
+		if b.Resolver != "" && !resolvers[b.Resolver] {
+			return fmt.Errorf("backend %v references unknown resolver %v", b.Name, b.Resolver)
+		}
+		backends[b.Name] = true
+	}
+
+	frontends := make(map[string]bool)
+	for i := range r.Frontends {
+		f := &r.Frontends[i]
+		if err := f.Validate(); err != nil {
+			return err
+		}
+		if frontends[f.Name] {
+			return fmt.Errorf("frontend %v is defined more than once", f.Name)
+		}
+		frontends[f.Name] = true
+		if !backends[f.DefaultBackend] {
+			return fmt.Errorf("frontend %v references unknown backend %v", f.Name, f.DefaultBackend)
+		}
+		for _, n := range f.Networks {
+			if !networks[n.Name] {
+				return fmt.Errorf("frontend %v references unknown network %v", f.Name, n.Name)
+			}
+		}
+		for _, rule := range f.Rules {
+			for _, a := range rule.Actions {
+				if a.ActionUseBackend != nil && !backends[a.ActionUseBackend.Backend] {
+					return fmt.Errorf("frontend %v rule %v references unknown backend %v", f.Name, rule.Name, a.ActionUseBackend.Backend)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// ListLoadBalancerPlans returns the available load balancer plans.
+// https://developers.upcloud.com/1.3/17-managed-loadbalancer/#list-plans
+func (s *LoadBalancerService) ListLoadBalancerPlans(ctx context.Context) ([]LoadBalancerPlan, *http.Response, error) {
+	req, err := s.client.NewRequest("GET", "load-balancer/plans", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var plans []LoadBalancerPlan
+	resp, err := s.client.Do(ctx, req, &plans)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return plans, resp, nil
+}
+
+// ListLoadBalancers returns the load balancers on the account.
+// https://developers.upcloud.com/1.3/17-managed-loadbalancer/#list-services
+func (s *LoadBalancerService) ListLoadBalancers(ctx context.Context) ([]LoadBalancer, *http.Response, error) {
+	req, err := s.client.NewRequest("GET", "load-balancer", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var loadBalancers []LoadBalancer
+	resp, err := s.client.Do(ctx, req, &loadBalancers)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return loadBalancers, resp, nil
+}
+
+// GetLoadBalancerDetails returns the load balancer with the given uuid.
+// https://developers.upcloud.com/1.3/17-managed-loadbalancer/#get-service-details
+func (s *LoadBalancerService) GetLoadBalancerDetails(ctx context.Context, uuid string) (*LoadBalancer, *http.Response, error) {
+	u := fmt.Sprintf("load-balancer/%v", uuid)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	loadBalancer := new(LoadBalancer)
+	resp, err := s.client.Do(ctx, req, loadBalancer)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return loadBalancer, resp, nil
+}
+
+// CreateLoadBalancer validates and creates a new load balancer with the properties defined in r.
+// https://developers.upcloud.com/1.3/17-managed-loadbalancer/#create-service
+func (s *LoadBalancerService) CreateLoadBalancer(ctx context.Context, r *CreateLoadBalancerRequest) (*LoadBalancer, *http.Response, error) {
+	if err := r.Validate(); err != nil {
+		return nil, nil, err
+	}
+	req, err := s.client.NewRequest("POST", "load-balancer", r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	loadBalancer := new(LoadBalancer)
+	resp, err := s.client.Do(ctx, req, loadBalancer)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return loadBalancer, resp, nil
+}
+
+// ModifyLoadBalancerRequest represents the properties of a load balancer to modify, empty values are left unchanged
+type ModifyLoadBalancerRequest struct {
+	Name             string  `json:"name,omitempty"`
+	Plan             string  `json:"plan,omitempty"`
+	ConfiguredStatus string  `json:"configured_status,omitempty"` //started/stopped
+	Labels           []Label `json:"labels,omitempty"`
+}
+
+// ModifyLoadBalancer modifies the load balancer with the given uuid using the properties defined in r.
+// https://developers.upcloud.com/1.3/17-managed-loadbalancer/#modify-service
+func (s *LoadBalancerService) ModifyLoadBalancer(ctx context.Context, uuid string, r *ModifyLoadBalancerRequest) (*LoadBalancer, *http.Response, error) {
+	u := fmt.Sprintf("load-balancer/%v", uuid)
+	req, err := s.client.NewRequest("PATCH", u, r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	loadBalancer := new(LoadBalancer)
+	resp, err := s.client.Do(ctx, req, loadBalancer)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return loadBalancer, resp, nil
+}
+
+// DeleteLoadBalancer deletes the load balancer with the given uuid.
+// https://developers.upcloud.com/1.3/17-managed-loadbalancer/#delete-service
+func (s *LoadBalancerService) DeleteLoadBalancer(ctx context.Context, uuid string) (*http.Response, error) {
+	u := fmt.Sprintf("load-balancer/%v", uuid)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListLoadBalancerFrontends returns the frontends of the load balancer with the given uuid.
+// https://developers.upcloud.com/1.3/17-managed-loadbalancer/#list-frontends
+func (s *LoadBalancerService) ListLoadBalancerFrontends(ctx context.Context, uuid string) ([]LoadBalancerFrontend, *http.Response, error) {
+	u := fmt.Sprintf("load-balancer/%v/frontends", uuid)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var frontends []LoadBalancerFrontend
+	resp, err := s.client.Do(ctx, req, &frontends)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return frontends, resp, nil
+}
+
+// GetLoadBalancerFrontend returns the frontend with the given name.
+// https://developers.upcloud.com/1.3/17-managed-loadbalancer/#get-frontend-details
+func (s *LoadBalancerService) GetLoadBalancerFrontend(ctx context.Context, uuid string, name string) (*LoadBalancerFrontend, *http.Response, error) {
+	u := fmt.Sprintf("load-balancer/%v/frontends/%v", uuid, name)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	frontend := new(LoadBalancerFrontend)
+	resp, err := s.client.Do(ctx, req, frontend)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return frontend, resp, nil
+}
+
+// CreateLoadBalancerFrontend adds a frontend of the load balancer with the given uuid.
+// https://developers.upcloud.com/1.3/17-managed-loadbalancer/#create-frontend
+func (s *LoadBalancerService) CreateLoadBalancerFrontend(ctx context.Context, uuid string, r *LoadBalancerFrontend) (*LoadBalancerFrontend, *http.Response, error) {
+	if err := r.Validate(); err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("load-balancer/%v/frontends", uuid)
+	req, err := s.client.NewRequest("POST", u, r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	frontend := new(LoadBalancerFrontend)
+	resp, err := s.client.Do(ctx, req, frontend)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return frontend, resp, nil
+}
+
+// ModifyLoadBalancerFrontend modifies the frontend with the given name using the properties set in r.
+// https://developers.upcloud.com/1.3/17-managed-loadbalancer/#modify-frontend
+func (s *LoadBalancerService) ModifyLoadBalancerFrontend(ctx context.Context, uuid string, name string, r *LoadBalancerFrontend) (*LoadBalancerFrontend, *http.Response, error) {
+	u := fmt.Sprintf("load-balancer/%v/frontends/%v", uuid, name)
+	req, err := s.client.NewRequest("PATCH", u, r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	frontend := new(LoadBalancerFrontend)
+	resp, err := s.client.Do(ctx, req, frontend)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return frontend, resp, nil
+}
+
+// DeleteLoadBalancerFrontend removes the frontend with the given name.
+// https://developers.upcloud.com/1.3/17-managed-loadbalancer/#delete-frontend
+func (s *LoadBalancerService) DeleteLoadBalancerFrontend(ctx context.Context, uuid string, name string) (*http.Response, error) {
+	u := fmt.Sprintf("load-balancer/%v/frontends/%v", uuid, name)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListLoadBalancerFrontendRules returns the frontend rules of a frontend of the load balancer with the given uuid.
+// https://developers.upcloud.com/1.3/17-managed-loadbalancer/#list-frontend-rules
+func (s *LoadBalancerService) ListLoadBalancerFrontendRules(ctx context.Context, uuid string, frontend string) ([]LoadBalancerRule, *http.Response, error) {
+	u := fmt.Sprintf("load-balancer/%v/frontends/%v/rules", uuid, frontend)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rules []LoadBalancerRule
+	resp, err := s.client.Do(ctx, req, &rules)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return rules, resp, nil
+}
+
+// GetLoadBalancerFrontendRule returns the frontend rule with the given name.
+// https://developers.upcloud.com/1.3/17-managed-loadbalancer/#get-frontend-rule-details
+func (s *LoadBalancerService) GetLoadBalancerFrontendRule(ctx context.Context, uuid string, frontend string, name string) (*LoadBalancerRule, *http.Response, error) {
+	u := fmt.Sprintf("load-balancer/%v/frontends/%v/rules/%v", uuid, frontend, name)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rule := new(LoadBalancerRule)
+	resp, err := s.client.Do(ctx, req, rule)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return rule, resp, nil
+}
+
+// CreateLoadBalancerFrontendRule adds a frontend rule of a frontend of the load balancer with the given uuid.
+// https://developers.upcloud.com/1.3/17-managed-loadbalancer/#create-frontend-rule
+func (s *LoadBalancerService) CreateLoadBalancerFrontendRule(ctx context.Context, uuid string, frontend string, r *LoadBalancerRule) (*LoadBalancerRule, *http.Response, error) {
+	if err := r.Validate(); err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("load-balancer/%v/frontends/%v/rules", uuid, frontend)
+	req, err := s.client.NewRequest("POST", u, r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rule := new(LoadBalancerRule)
+	resp, err := s.client.Do(ctx, req, rule)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return rule, resp, nil
+}
+
+// ModifyLoadBalancerFrontendRule modifies the frontend rule with the given name using the properties set in r.
+// https://developers.upcloud.com/1.3/17-managed-loadbalancer/#modify-frontend-rule
+func (s *LoadBalancerService) ModifyLoadBalancerFrontendRule(ctx context.Context, uuid string, frontend string, name string, r *LoadBalancerRule) (*LoadBalancerRule, *http.Response, error) {
+	u := fmt.Sprintf("load-balancer/%v/frontends/%v/rules/%v", uuid, frontend, name)
+	req, err := s.client.NewRequest("PATCH", u, r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rule := new(LoadBalancerRule)
+	resp, err := s.client.Do(ctx, req, rule)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return rule, resp, nil
+}
+
+// DeleteLoadBalancerFrontendRule removes the frontend rule with the given name.
+// https://developers.upcloud.com/1.3/17-managed-loadbalancer/#delete-frontend-rule
+func (s *LoadBalancerService) DeleteLoadBalancerFrontendRule(ctx context.Context, uuid string, frontend string, name string) (*http.Response, error) {
+	u := fmt.Sprintf("load-balancer/%v/frontends/%v/rules/%v", uuid, frontend, name)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListLoadBalancerFrontendTLSConfigs returns the frontend TLS configs of a frontend of the load balancer with the given uuid.
+// https://developers.upcloud.com/1.3/17-managed-loadbalancer/#list-frontend-tls-configs
+func (s *LoadBalancerService) ListLoadBalancerFrontendTLSConfigs(ctx context.Context, uuid string, frontend string) ([]LoadBalancerTLSConfig, *http.Response, error) {
+	u := fmt.Sprintf("load-balancer/%v/frontends/%v/tls-configs", uuid, frontend)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var tlsConfigs []LoadBalancerTLSConfig
+	resp, err := s.client.Do(ctx, req, &tlsConfigs)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return tlsConfigs, resp, nil
+}
+
+// GetLoadBalancerFrontendTLSConfig returns the frontend TLS config with the given name.
+// https://developers.upcloud.com/1.3/17-managed-loadbalancer/#get-frontend-tls-config-details
+func (s *LoadBalancerService) GetLoadBalancerFrontendTLSConfig(ctx context.Context, uuid string, frontend string, name string) (*LoadBalancerTLSConfig, *http.Response, error) {
+	u := fmt.Sprintf("load-balancer/%v/frontends/%v/tls-configs/%v", uuid, frontend, name)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tlsConfig := new(LoadBalancerTLSConfig)
+	resp, err := s.client.Do(ctx, req, tlsConfig)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return tlsConfig, resp, nil
+}
+
+// CreateLoadBalancerFrontendTLSConfig adds a frontend TLS config of a frontend of the load balancer with the given uuid.
+// https://developers.upcloud.com/1.3/17-managed-loadbalancer/#create-frontend-tls-config
+func (s *LoadBalancerService) CreateLoadBalancerFrontendTLSConfig(ctx context.Context, uuid string, frontend string, r *LoadBalancerTLSConfig) (*LoadBalancerTLSConfig, *http.Response, error) {
+	u := fmt.Sprintf("load-balancer/%v/frontends/%v/tls-configs", uuid, frontend)
+	req, err := s.client.NewRequest("POST", u, r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tlsConfig := new(LoadBalancerTLSConfig)
+	resp, err := s.client.Do(ctx, req, tlsConfig)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return tlsConfig, resp, nil
+}
+
+// ModifyLoadBalancerFrontendTLSConfig modifies the frontend TLS config with the given name using the properties set in r.
+// https://developers.upcloud.com/1.3/17-managed-loadbalancer/#modify-frontend-tls-config
+func (s *LoadBalancerService) ModifyLoadBalancerFrontendTLSConfig(ctx context.Context, uuid string, frontend string, name string, r *LoadBalancerTLSConfig) (*LoadBalancerTLSConfig, *http.Response, error) {
+	u := fmt.Sprintf("load-balancer/%v/frontends/%v/tls-configs/%v", uuid, frontend, name)
+	req, err := s.client.NewRequest("PATCH", u, r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tlsConfig := new(LoadBalancerTLSConfig)
+	resp, err := s.client.Do(ctx, req, tlsConfig)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return tlsConfig, resp, nil
+}
+
+// DeleteLoadBalancerFrontendTLSConfig removes the frontend TLS config with the given name.
+// https://developers.upcloud.com/1.3/17-managed-loadbalancer/#delete-frontend-tls-config
+func (s *LoadBalancerService) DeleteLoadBalancerFrontendTLSConfig(ctx context.Context, uuid string, frontend string, name string) (*http.Response, error) {
+	u := fmt.Sprintf("load-balancer/%v/frontends/%v/tls-configs/%v", uuid, frontend, name)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListLoadBalancerBackends returns the backends of the load balancer with the given uuid.
+// https://developers.upcloud.com/1.3/17-managed-loadbalancer/#list-backends
+func (s *LoadBalancerService) ListLoadBalancerBackends(ctx context.Context, uuid string) ([]LoadBalancerBackend, *http.Response, error) {
+	u := fmt.Sprintf("load-balancer/%v/backends", uuid)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var backends []LoadBalancerBackend
+	resp, err := s.client.Do(ctx, req, &backends)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return backends, resp, nil
+}
+
+// GetLoadBalancerBackend returns the backend with the given name.
+// https://developers.upcloud.com/1.3/17-managed-loadbalancer/#get-backend-details
+func (s *LoadBalancerService) GetLoadBalancerBackend(ctx context.Context, uuid string, name string) (*LoadBalancerBackend, *http.Response, error) {
+	u := fmt.Sprintf("load-balancer/%v/backends/%v", uuid, name)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	backend := new(LoadBalancerBackend)
+	resp, err := s.client.Do(ctx, req, backend)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return backend, resp, nil
+}
+
+// CreateLoadBalancerBackend adds a backend of the load balancer with the given uuid.
+// https://developers.upcloud.com/1.3/17-managed-loadbalancer/#create-backend
+func (s *LoadBalancerService) CreateLoadBalancerBackend(ctx context.Context, uuid string, r *LoadBalancerBackend) (*LoadBalancerBackend, *http.Response, error) {
+	if err := r.Validate(); err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("load-balancer/%v/backends", uuid)
+	req, err := s.client.NewRequest("POST", u, r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	backend := new(LoadBalancerBackend)
+	resp, err := s.client.Do(ctx, req, backend)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return backend, resp, nil
+}
+
+// ModifyLoadBalancerBackend modifies the backend with the given name using the properties set in r.
+// https://developers.upcloud.com/1.3/17-managed-loadbalancer/#modify-backend
+func (s *LoadBalancerService) ModifyLoadBalancerBackend(ctx context.Context, uuid string, name string, r *LoadBalancerBackend) (*LoadBalancerBackend, *http.Response, error) {
+	u := fmt.Sprintf("load-balancer/%v/backends/%v", uuid, name)
+	req, err := s.client.NewRequest("PATCH", u, r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	backend := new(LoadBalancerBackend)
+	resp, err := s.client.Do(ctx, req, backend)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return backend, resp, nil
+}
+
+// DeleteLoadBalancerBackend removes the backend with the given name.
+// https://developers.upcloud.com/1.3/17-managed-loadbalancer/#delete-backend
+func (s *LoadBalancerService) DeleteLoadBalancerBackend(ctx context.Context, uuid string, name string) (*http.Response, error) {
+	u := fmt.Sprintf("load-balancer/%v/backends/%v", uuid, name)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListLoadBalancerBackendMembers returns the backend members of a backend of the load balancer with the given uuid.
+// https://developers.upcloud.com/1.3/17-managed-loadbalancer/#list-backend-members
+func (s *LoadBalancerService) ListLoadBalancerBackendMembers(ctx context.Context, uuid string, backend string) ([]LoadBalancerBackendMember, *http.Response, error) {
+	u := fmt.Sprintf("load-balancer/%v/backends/%v/members", uuid, backend)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var members []LoadBalancerBackendMember
+	resp, err := s.client.Do(ctx, req, &members)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return members, resp, nil
+}
+
+// GetLoadBalancerBackendMember returns the backend member with the given name.
+// https://developers.upcloud.com/1.3/17-managed-loadbalancer/#get-backend-member-details
+func (s *LoadBalancerService) GetLoadBalancerBackendMember(ctx context.Context, uuid string, backend string, name string) (*LoadBalancerBackendMember, *http.Response, error) {
+	u := fmt.Sprintf("load-balancer/%v/backends/%v/members/%v", uuid, backend, name)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	member := new(LoadBalancerBackendMember)
+	resp, err := s.client.Do(ctx, req, member)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return member, resp, nil
+}
+
+// CreateLoadBalancerBackendMember adds a backend member of a backend of the load balancer with the given uuid.
+// https://developers.upcloud.com/1.3/17-managed-loadbalancer/#create-backend-member
+func (s *LoadBalancerService) CreateLoadBalancerBackendMember(ctx context.Context, uuid string, backend string, r *LoadBalancerBackendMember) (*LoadBalancerBackendMember, *http.Response, error) {
+	if err := r.Validate(); err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("load-balancer/%v/backends/%v/members", uuid, backend)
+	req, err := s.client.NewRequest("POST", u, r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	member := new(LoadBalancerBackendMember)
+	resp, err := s.client.Do(ctx, req, member)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return member, resp, nil
+}
+
+// ModifyLoadBalancerBackendMember modifies the backend member with the given name using the properties set in r.
+// https://developers.upcloud.com/1.3/17-managed-loadbalancer/#modify-backend-member
+func (s *LoadBalancerService) ModifyLoadBalancerBackendMember(ctx context.Context, uuid string, backend string, name string, r *LoadBalancerBackendMember) (*LoadBalancerBackendMember, *http.Response, error) {
+	u := fmt.Sprintf("load-balancer/%v/backends/%v/members/%v", uuid, backend, name)
+	req, err := s.client.NewRequest("PATCH", u, r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	member := new(LoadBalancerBackendMember)
+	resp, err := s.client.Do(ctx, req, member)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return member, resp, nil
+}
+
+// DeleteLoadBalancerBackendMember removes the backend member with the given name.
+// https://developers.upcloud.com/1.3/17-managed-loadbalancer/#delete-backend-member
+func (s *LoadBalancerService) DeleteLoadBalancerBackendMember(ctx context.Context, uuid string, backend string, name string) (*http.Response, error) {
+	u := fmt.Sprintf("load-balancer/%v/backends/%v/members/%v", uuid, backend, name)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListLoadBalancerResolvers returns the resolvers of the load balancer with the given uuid.
+// https://developers.upcloud.com/1.3/17-managed-loadbalancer/#list-resolvers
+func (s *LoadBalancerService) ListLoadBalancerResolvers(ctx context.Context, uuid string) ([]LoadBalancerResolver, *http.Response, error) {
+	u := fmt.Sprintf("load-balancer/%v/resolvers", uuid)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var resolvers []LoadBalancerResolver
+	resp, err := s.client.Do(ctx, req, &resolvers)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return resolvers, resp, nil
+}
+
+// GetLoadBalancerResolver returns the resolver with the given name.
+// https://developers.upcloud.com/1.3/17-managed-loadbalancer/#get-resolver-details
+func (s *LoadBalancerService) GetLoadBalancerResolver(ctx context.Context, uuid string, name string) (*LoadBalancerResolver, *http.Response, error) {
+	u := fmt.Sprintf("load-balancer/%v/resolvers/%v", uuid, name)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resolver := new(LoadBalancerResolver)
+	resp, err := s.client.Do(ctx, req, resolver)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return resolver, resp, nil
+}
+
+// CreateLoadBalancerResolver adds a resolver of the load balancer with the given uuid.
+// https://developers.upcloud.com/1.3/17-managed-loadbalancer/#create-resolver
+func (s *LoadBalancerService) CreateLoadBalancerResolver(ctx context.Context, uuid string, r *LoadBalancerResolver) (*LoadBalancerResolver, *http.Response, error) {
+	u := fmt.Sprintf("load-balancer/%v/resolvers", uuid)
+	req, err := s.client.NewRequest("POST", u, r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resolver := new(LoadBalancerResolver)
+	resp, err := s.client.Do(ctx, req, resolver)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return resolver, resp, nil
+}
+
+// ModifyLoadBalancerResolver modifies the resolver with the given name using the properties set in r.
+// https://developers.upcloud.com/1.3/17-managed-loadbalancer/#modify-resolver
+func (s *LoadBalancerService) ModifyLoadBalancerResolver(ctx context.Context, uuid string, name string, r *LoadBalancerResolver) (*LoadBalancerResolver, *http.Response, error) {
+	u := fmt.Sprintf("load-balancer/%v/resolvers/%v", uuid, name)
+	req, err := s.client.NewRequest("PATCH", u, r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resolver := new(LoadBalancerResolver)
+	resp, err := s.client.Do(ctx, req, resolver)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return resolver, resp, nil
+}
+
+// DeleteLoadBalancerResolver removes the resolver with the given name.
+// https://developers.upcloud.com/1.3/17-managed-loadbalancer/#delete-resolver
+func (s *LoadBalancerService) DeleteLoadBalancerResolver(ctx context.Context, uuid string, name string) (*http.Response, error) {
+	u := fmt.Sprintf("load-balancer/%v/resolvers/%v", uuid, name)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListLoadBalancerCertificateBundles returns the certificate bundles on the account.
+// https://developers.upcloud.com/1.3/17-managed-loadbalancer/#list-certificate-bundles
+func (s *LoadBalancerService) ListLoadBalancerCertificateBundles(ctx context.Context) ([]LoadBalancerCertificateBundle, *http.Response, error) {
+	req, err := s.client.NewRequest("GET", "load-balancer/certificate-bundles", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var bundles []LoadBalancerCertificateBundle
+	resp, err := s.client.Do(ctx, req, &bundles)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return bundles, resp, nil
+}
+
+// GetLoadBalancerCertificateBundle returns the certificate bundle with the given uuid.
+// https://developers.upcloud.com/1.3/17-managed-loadbalancer/#get-certificate-bundle-details
+func (s *LoadBalancerService) GetLoadBalancerCertificateBundle(ctx context.Context, uuid string) (*LoadBalancerCertificateBundle, *http.Response, error) {
+	u := fmt.Sprintf("load-balancer/certificate-bundles/%v", uuid)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	bundle := new(LoadBalancerCertificateBundle)
+	resp, err := s.client.Do(ctx, req, bundle)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return bundle, resp, nil
+}
+
+// CreateLoadBalancerCertificateBundle uploads or requests a new certificate bundle.
+// https://developers.upcloud.com/1.3/17-managed-loadbalancer/#create-certificate-bundle
+func (s *LoadBalancerService) CreateLoadBalancerCertificateBundle(ctx context.Context, r *LoadBalancerCertificateBundle) (*LoadBalancerCertificateBundle, *http.Response, error) {
+	req, err := s.client.NewRequest("POST", "load-balancer/certificate-bundles", r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	bundle := new(LoadBalancerCertificateBundle)
+	resp, err := s.client.Do(ctx, req, bundle)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return bundle, resp, nil
+}
+
+// ModifyLoadBalancerCertificateBundle modifies the certificate bundle with the given uuid using the properties set in r.
+// https://developers.upcloud.com/1.3/17-managed-loadbalancer/#modify-certificate-bundle
+func (s *LoadBalancerService) ModifyLoadBalancerCertificateBundle(ctx context.Context, uuid string, r *LoadBalancerCertificateBundle) (*LoadBalancerCertificateBundle, *http.Response, error) {
+	trimBundle := *r
+	trimBundle.UUID = ""
+	trimBundle.Type = ""
+	u := fmt.Sprintf("load-balancer/certificate-bundles/%v", uuid)
+	req, err := s.client.NewRequest("PATCH", u, &trimBundle)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	bundle := new(LoadBalancerCertificateBundle)
+	resp, err := s.client.Do(ctx, req, bundle)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return bundle, resp, nil
+}
+
+// DeleteLoadBalancerCertificateBundle deletes the certificate bundle with the given uuid.
+// https://developers.upcloud.com/1.3/17-managed-loadbalancer/#delete-certificate-bundle
+func (s *LoadBalancerService) DeleteLoadBalancerCertificateBundle(ctx context.Context, uuid string) (*http.Response, error) {
+	u := fmt.Sprintf("load-balancer/certificate-bundles/%v", uuid)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,333 @@
+package upcloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// NetworksService handles communication with the networking related methods of the UpCloud API
+// https://developers.upcloud.com/1.3/13-networks/
+type NetworksService service
+
+// Network types
+const (
+	NetworkTypePublic  = "public"
+	NetworkTypeUtility = "utility"
+	NetworkTypePrivate = "private"
+)
+
+// IPNetwork represents an IP network definition of an SDN network
+type IPNetwork struct {
+	Address          string   `json:"address"`                      //CIDR
+	DHCP             string   `json:"dhcp"`                         //yes/no
+	DHCPDefaultRoute string   `json:"dhcp_default_route,omitempty"` //yes/no
+	DHCPDNS          []string `json:"dhcp_dns,omitempty"`
+	Family           string   `json:"family"` //IPv4/IPv6
+	Gateway          string   `json:"gateway,omitempty"`
+}
+
+// IPNetworkList represents the list of IP networks of a network
+type IPNetworkList struct {
+	IPNetworks []IPNetwork `json:"ip_network"`
+}
+
+// NetworkServer represents a server attached to a network
+type NetworkServer struct {
+	Title string `json:"title"`
+	UUID  string `json:"uuid"`
+}
+
+// NetworkServerList represents the list of servers attached to a network
+type NetworkServerList struct {
+	Servers []NetworkServer `json:"server"`
+}
+
+// Network represents the properties of a network
+type Network struct {
+	IPNetworks IPNetworkList      `json:"ip_networks"`
+	Name       string             `json:"name"`
+	Router     string             `json:"router,omitempty"` //UUID of the attached router
+	Servers    *NetworkServerList `json:"servers,omitempty"`
+	Type       string             `json:"type"` //public/utility/private
+	UUID       string             `json:"uuid"`
+	Zone       string             `json:"zone"`
+}
+
+// NetworkList represents the list of networks
+type NetworkList struct {
+	Networks []Network `json:"network"`
+}
+
+// NetworkListResponse represents the response from the ListNetworks API call
+type NetworkListResponse struct {
+	NetworkList *NetworkList `json:"networks"`
+}
+
+// NetworkDetailsResponse represents the response from network details API methods
+type NetworkDetailsResponse struct {
+	Network *Network `json:"network"`
+}
+
+// ListNetworks returns the networks available to the account, optionally only those in zone.
+// https://developers.upcloud.com/1.3/13-networks/#list-networks
+func (s *NetworksService) ListNetworks(ctx context.Context, zone string) (*NetworkList, *http.Response, error) {
+	u := "network/"
+	if zone != "" {
+		q := url.Values{}
+		q.Set("zone", zone)
+		u += "?" + q.Encode()
+	}
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	networkList := new(NetworkList)
+	resp, err := s.client.Do(ctx, req, &NetworkListResponse{NetworkList: networkList})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return networkList, resp, nil
+}
+
+// GetNetworkDetails returns the properties of the network with the given uuid.
+// https://developers.upcloud.com/1.3/13-networks/#get-network-details
+func (s *NetworksService) GetNetworkDetails(ctx context.Context, uuid string) (*Network, *http.Response, error) {
+	u := fmt.Sprintf("network/%v", uuid)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	network := new(Network)
+	resp, err := s.client.Do(ctx, req, &NetworkDetailsResponse{Network: network})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return network, resp, nil
+}
+
+// CreateNetworkRequest represents the properties of a new SDN private network
+type CreateNetworkRequest struct {
+	Name       string        `json:"name"`
+	Zone       string        `json:"zone"`
+	Router     string        `json:"router,omitempty"`
+	IPNetworks IPNetworkList `json:"ip_networks"`
+}
+
+type createNetworkRequestWrapper struct {
+	Network *CreateNetworkRequest `json:"network"`
+}
+
+// CreateNetwork creates a new SDN private network with the properties defined in r.
+// https://developers.upcloud.com/1.3/13-networks/#create-network
+func (s *NetworksService) CreateNetwork(ctx context.Context, r *CreateNetworkRequest) (*Network, *http.Response, error) {
+	req, err := s.client.NewRequest("POST", "network/", &createNetworkRequestWrapper{Network: r})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	network := new(Network)
+	resp, err := s.client.Do(ctx, req, &NetworkDetailsResponse{Network: network})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return network, resp, nil
+}
+
+// ModifyNetworkRequest represents the properties of a network to modify, empty values are left unchanged
+type ModifyNetworkRequest struct {
+	Name       string         `json:"name,omitempty"`
+	IPNetworks *IPNetworkList `json:"ip_networks,omitempty"`
+}
+
+type modifyNetworkRequestWrapper struct {
+	Network *ModifyNetworkRequest `json:"network"`
+}
+
+// ModifyNetwork modifies the SDN private network with the given uuid using the properties defined in r.
+// https://developers.upcloud.com/1.3/13-networks/#modify-network
+func (s *NetworksService) ModifyNetwork(ctx context.Context, uuid string, r *ModifyNetworkRequest) (*Network, *http.Response, error) {
+	u := fmt.Sprintf("network/%v", uuid)
+	req, err := s.client.NewRequest("PUT", u, &modifyNetworkRequestWrapper{Network: r})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	network := new(Network)
+	resp, err := s.client.Do(ctx, req, &NetworkDetailsResponse{Network: network})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return network, resp, nil
+}
+
+// DeleteNetwork deletes the SDN private network with the given uuid, no servers may be attached to it.
+// https://developers.upcloud.com/1.3/13-networks/#delete-network
+func (s *NetworksService) DeleteNetwork(ctx context.Context, uuid string) (*http.Response, error) {
+	u := fmt.Sprintf("network/%v", uuid)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ServerInterfaceIPAddress represents an IP address of a server network interface
+type ServerInterfaceIPAddress struct {
+	Address  string `json:"address,omitempty"`
+	Family   string `json:"family"`             //IPv4/IPv6
+	Floating string `json:"floating,omitempty"` //yes/no
+}
+
+// ServerInterfaceIPAddressList represents the list of IP addresses of a server network interface
+type ServerInterfaceIPAddressList struct {
+	IPAddresses []ServerInterfaceIPAddress `json:"ip_address"`
+}
+
+// ServerInterface represents a network interface of a server
+type ServerInterface struct {
+	Bootable          string                       `json:"bootable,omitempty"` //yes/no
+	Index             int                          `json:"index"`
+	IPAddresses       ServerInterfaceIPAddressList `json:"ip_addresses"`
+	MAC               string                       `json:"mac"`
+	Network           string                       `json:"network"`
+	SourceIPFiltering string                       `json:"source_ip_filtering,omitempty"` //yes/no
+	Type              string                       `json:"type"`                          //public/utility/private
+}
+
+// ServerInterfaceList represents the list of network interfaces of a server
+type ServerInterfaceList struct {
+	Interfaces []ServerInterface `json:"interface"`
+}
+
+// ServerNetworking represents the networking configuration of a server
+type ServerNetworking struct {
+	Interfaces ServerInterfaceList `json:"interfaces"`
+}
+
+// ServerNetworkingResponse represents the response from the ListServerInterfaces API call
+type ServerNetworkingResponse struct {
+	Networking *ServerNetworking `json:"networking"`
+}
+
+// ServerInterfaceDetailsResponse represents the response from server interface details API methods
+type ServerInterfaceDetailsResponse struct {
+	Interface *ServerInterface `json:"interface"`
+}
+
+// ListServerInterfaces returns the network interfaces of the server with the given uuid.
+// https://developers.upcloud.com/1.3/13-networks/#list-server-networks
+func (s *NetworksService) ListServerInterfaces(ctx context.Context, serverUUID string) (*ServerNetworking, *http.Response, error) {
+	u := fmt.Sprintf("server/%v/networking", serverUUID)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	networking := new(ServerNetworking)
+	resp, err := s.client.Do(ctx, req, &ServerNetworkingResponse{Networking: networking})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return networking, resp, nil
+}
+
+// GetServerInterface returns the network interface at index of the server with the given uuid.
+// https://developers.upcloud.com/1.3/13-networks/#get-interface-details
+func (s *NetworksService) GetServerInterface(ctx context.Context, serverUUID string, index int) (*ServerInterface, *http.Response, error) {
+	u := fmt.Sprintf("server/%v/networking/interface/%v", serverUUID, index)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	iface := new(ServerInterface)
+	resp, err := s.client.Do(ctx, req, &ServerInterfaceDetailsResponse{Interface: iface})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return iface, resp, nil
+}
+
+// CreateServerInterfaceRequest represents the properties of a new server network interface
+type CreateServerInterfaceRequest struct {
+	Type              string                       `json:"type"`                   //public/utility/private
+	NetworkUUID       string                       `json:"network_uuid,omitempty"` //Only for private interfaces
+	Index             int                          `json:"index,omitempty"`
+	IPAddresses       ServerInterfaceIPAddressList `json:"ip_addresses"`
+	SourceIPFiltering string                       `json:"source_ip_filtering,omitempty"` //yes/no
+	Bootable          string                       `json:"bootable,omitempty"`            //yes/no
+}
+
+type createServerInterfaceRequestWrapper struct {
+	Interface *CreateServerInterfaceRequest `json:"interface"`
+}
+
+// CreateServerInterface adds a network interface to the server with the given uuid, the server must be stopped.
+// https://developers.upcloud.com/1.3/13-networks/#create-network-interface
+func (s *NetworksService) CreateServerInterface(ctx context.Context, serverUUID string, r *CreateServerInterfaceRequest) (*ServerInterface, *http.Response, error) {
+	u := fmt.Sprintf("server/%v/networking/interface", serverUUID)
+	req, err := s.client.NewRequest("POST", u, &createServerInterfaceRequestWrapper{Interface: r})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	iface := new(ServerInterface)
+	resp, err := s.client.Do(ctx, req, &ServerInterfaceDetailsResponse{Interface: iface})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return iface, resp, nil
+}
+
+// ModifyServerInterfaceRequest represents the properties of a server network interface to modify
+type ModifyServerInterfaceRequest struct {
+	Index             int    `json:"index,omitempty"`               //Moves the interface to a new index
+	SourceIPFiltering string `json:"source_ip_filtering,omitempty"` //yes/no
+	Bootable          string `json:"bootable,omitempty"`            //yes/no
+}
+
+type modifyServerInterfaceRequestWrapper struct {
+	Interface *ModifyServerInterfaceRequest `json:"interface"`
+}
+
+// ModifyServerInterface modifies the network interface at index of the server with the given uuid.
+// https://developers.upcloud.com/1.3/13-networks/#modify-network-interface
+func (s *NetworksService) ModifyServerInterface(ctx context.Context, serverUUID string, index int, r *ModifyServerInterfaceRequest) (*ServerInterface, *http.Response, error) {
+	u := fmt.Sprintf("server/%v/networking/interface/%v", serverUUID, index)
+	req, err := s.client.NewRequest("PUT", u, &modifyServerInterfaceRequestWrapper{Interface: r})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	iface := new(ServerInterface)
+	resp, err := s.client.Do(ctx, req, &ServerInterfaceDetailsResponse{Interface: iface})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return iface, resp, nil
+}
+
+// DeleteServerInterface removes the network interface at index from the server with the given uuid.
+// https://developers.upcloud.com/1.3/13-networks/#delete-network-interface
+func (s *NetworksService) DeleteServerInterface(ctx context.Context, serverUUID string, index int) (*http.Response, error) {
+	u := fmt.Sprintf("server/%v/networking/interface/%v", serverUUID, index)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,375 @@
+package upcloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// ObjectStorageService handles communication with the managed object storage related methods of the UpCloud API
+// https://developers.upcloud.com/1.3/21-managed-object-storage/
+type ObjectStorageService service
+
+// Configured status of an object storage instance
+const (
+	ObjectStorageStatusStarted = "started"
+	ObjectStorageStatusStopped = "stopped"
+)
+
+// Access key status
+const (
+	AccessKeyStatusActive   = "Active"
+	AccessKeyStatusInactive = "Inactive"
+)
+
+// Label represents a key/value label attached to a resource
+type Label struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// ObjectStorageRegionZone represents a zone within an object storage region
+type ObjectStorageRegionZone struct {
+	Name string `json:"name"`
+}
+
+// ObjectStorageRegion represents a region object storage instances can be created in
+type ObjectStorageRegion struct {
+	Name        string                    `json:"name"`
+	PrimaryZone string                    `json:"primary_zone"`
+	Zones       []ObjectStorageRegionZone `json:"zones"`
+}
+
+// ObjectStorageNetwork represents a network an object storage instance is reachable from
+type ObjectStorageNetwork struct {
+	Name   string `json:"name"`
+	Type   string `json:"type"`           //public/private
+	Family string `json:"family"`         //IPv4
+	UUID   string `json:"uuid,omitempty"` //SDN network UUID, only for private networks
+}
+
+// ObjectStorageEndpoint represents an S3 endpoint of an object storage instance
+type ObjectStorageEndpoint struct {
+	DomainName string `json:"domain_name"`
+	Type       string `json:"type"` //public/private
+}
+
+// ObjectStorageUser represents a user of an object storage instance
+type ObjectStorageUser struct {
+	Username   string                   `json:"username"`
+	ARN        string                   `json:"arn,omitempty"`
+	AccessKeys []ObjectStorageAccessKey `json:"access_keys,omitempty"`
+	CreatedAt  time.Time                `json:"created_at"`
+	UpdatedAt  time.Time                `json:"updated_at"`
+}
+
+// ObjectStorageAccessKey represents an S3 access key of an object storage user.
+// SecretAccessKey is only returned when the key is created.
+type ObjectStorageAccessKey struct {
+	AccessKeyID     string     `json:"access_key_id"`
+	SecretAccessKey string     `json:"secret_access_key,omitempty"`
+	Status          string     `json:"status"` //Active/Inactive
+	CreatedAt       time.Time  `json:"created_at"`
+	LastUsedAt      *time.Time `json:"last_used_at,omitempty"`
+}
+
+// ObjectStorage represents a managed object storage instance
+type ObjectStorage struct {
+	UUID             string                  `json:"uuid"`
+	Name             string                  `json:"name"`
+	Region           string                  `json:"region"`
+	ConfiguredStatus string                  `json:"configured_status"` //started/stopped
+	OperationalState string                  `json:"operational_state"`
+	Endpoints        []ObjectStorageEndpoint `json:"endpoints"`
+	Networks         []ObjectStorageNetwork  `json:"networks"`
+	Users            []ObjectStorageUser     `json:"users"`
+	Labels           []Label                 `json:"labels"`
+	CreatedAt        time.Time               `json:"created_at"`
+	UpdatedAt        time.Time               `json:"updated_at"`
+}
+
+// ObjectStorageBucket represents a bucket and its usage in an object storage instance
+type ObjectStorageBucket struct {
+	Name           string `json:"name"`
+	TotalObjects   int64  `json:"total_objects"`
+	TotalSizeBytes int64  `json:"total_size_bytes"`
+}
+
+// ObjectStorageMetrics represents the usage statistics of an object storage instance
+type ObjectStorageMetrics struct {
+	TotalObjects   int64 `json:"total_objects"`
+	TotalSizeBytes int64 `json:"total_size_bytes"`
+}
+
+// ListObjectStorageRegions returns the regions and the zones they cover.
+// https://developers.upcloud.com/1.3/21-managed-object-storage/#list-regions
+func (s *ObjectStorageService) ListObjectStorageRegions(ctx context.Context) ([]ObjectStorageRegion, *http.Response, error) {
+	req, err := s.client.NewRequest("GET", "object-storage-2/regions", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var regions []ObjectStorageRegion
+	resp, err := s.client.Do(ctx, req, &regions)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return regions, resp, nil
+}
+
+// ListObjectStorages returns the object storage instances on the account.
+// https://developers.upcloud.com/1.3/21-managed-object-storage/#list-services
+func (s *ObjectStorageService) ListObjectStorages(ctx context.Context) ([]ObjectStorage, *http.Response, error) {
+	req, err := s.client.NewRequest("GET", "object-storage-2", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var storages []ObjectStorage
+	resp, err := s.client.Do(ctx, req, &storages)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return storages, resp, nil
+}
+
+// GetObjectStorageDetails returns the object storage instance with the given uuid.
+// https://developers.upcloud.com/1.3/21-managed-object-storage/#get-service-details
+func (s *ObjectStorageService) GetObjectStorageDetails(ctx context.Context, uuid string) (*ObjectStorage, *http.Response, error) {
+	u := fmt.Sprintf("object-storage-2/%v", uuid)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	storage := new(ObjectStorage)
+	resp, err := s.client.Do(ctx, req, storage)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return storage, resp, nil
+}
+
+// CreateObjectStorageRequest represents the properties of a new object storage instance
+type CreateObjectStorageRequest struct {
+	Name             string                 `json:"name"`
+	Region           string                 `json:"region"`
+	ConfiguredStatus string                 `json:"configured_status"` //started/stopped
+	Networks         []ObjectStorageNetwork `json:"networks"`
+	Labels           []Label                `json:"labels,omitempty"`
+}
+
+// CreateObjectStorage creates a new object storage instance with the properties defined in r.
+// https://developers.upcloud.com/1.3/21-managed-object-storage/#create-service
+func (s *ObjectStorageService) CreateObjectStorage(ctx context.Context, r *CreateObjectStorageRequest) (*ObjectStorage, *http.Response, error) {
+	req, err := s.client.NewRequest("POST", "object-storage-2", r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	storage := new(ObjectStorage)
+	resp, err := s.client.Do(ctx, req, storage)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return storage, resp, nil
+}
+
+// ModifyObjectStorageRequest represents the properties of an object storage instance to modify, empty values are left unchanged
+type ModifyObjectStorageRequest struct {
+	Name             string                 `json:"name,omitempty"`
+	ConfiguredStatus string                 `json:"configured_status,omitempty"` //started/stopped
+	Networks         []ObjectStorageNetwork `json:"networks,omitempty"`
+	Labels           []Label                `json:"labels,omitempty"`
+}
+
+// ModifyObjectStorage modifies the object storage instance with the given uuid using the properties defined in r.
+// https://developers.upcloud.com/1.3/21-managed-object-storage/#modify-service
+func (s *ObjectStorageService) ModifyObjectStorage(ctx context.Context, uuid string, r *ModifyObjectStorageRequest) (*ObjectStorage, *http.Response, error) {
+	u := fmt.Sprintf("object-storage-2/%v", uuid)
+	req, err := s.client.NewRequest("PATCH", u, r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	storage := new(ObjectStorage)
+	resp, err := s.client.Do(ctx, req, storage)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return storage, resp, nil
+}
+
+// DeleteObjectStorage deletes the object storage instance with the given uuid and all its data.
+// https://developers.upcloud.com/1.3/21-managed-object-storage/#delete-service
+func (s *ObjectStorageService) DeleteObjectStorage(ctx context.Context, uuid string) (*http.Response, error) {
+	u := fmt.Sprintf("object-storage-2/%v", uuid)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListObjectStorageUsers returns the users of the object storage instance with the given uuid.
+// https://developers.upcloud.com/1.3/21-managed-object-storage/#list-users
+func (s *ObjectStorageService) ListObjectStorageUsers(ctx context.Context, uuid string) ([]ObjectStorageUser, *http.Response, error) {
+	u := fmt.Sprintf("object-storage-2/%v/users", uuid)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var users []ObjectStorageUser
+	resp, err := s.client.Do(ctx, req, &users)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return users, resp, nil
+}
+
+// CreateObjectStorageUser creates a user that access keys can be issued for.
+// https://developers.upcloud.com/1.3/21-managed-object-storage/#create-user
+func (s *ObjectStorageService) CreateObjectStorageUser(ctx context.Context, uuid string, username string) (*ObjectStorageUser, *http.Response, error) {
+	u := fmt.Sprintf("object-storage-2/%v/users", uuid)
+	body := &struct {
+		Username string `json:"username"`
+	}{username}
+	req, err := s.client.NewRequest("POST", u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	user := new(ObjectStorageUser)
+	resp, err := s.client.Do(ctx, req, user)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return user, resp, nil
+}
+
+// DeleteObjectStorageUser deletes a user and its access keys.
+// https://developers.upcloud.com/1.3/21-managed-object-storage/#delete-user
+func (s *ObjectStorageService) DeleteObjectStorageUser(ctx context.Context, uuid string, username string) (*http.Response, error) {
+	u := fmt.Sprintf("object-storage-2/%v/users/%v", uuid, username)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListObjectStorageAccessKeys returns the access keys of a user, without their secrets.
+// https://developers.upcloud.com/1.3/21-managed-object-storage/#list-access-keys
+func (s *ObjectStorageService) ListObjectStorageAccessKeys(ctx context.Context, uuid string, username string) ([]ObjectStorageAccessKey, *http.Response, error) {
+	u := fmt.Sprintf("object-storage-2/%v/users/%v/access-keys", uuid, username)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var keys []ObjectStorageAccessKey
+	resp, err := s.client.Do(ctx, req, &keys)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return keys, resp, nil
+}
+
+// CreateObjectStorageAccessKey issues a new access key for a user, the secret is only returned here.
+// https://developers.upcloud.com/1.3/21-managed-object-storage/#create-access-key
+func (s *ObjectStorageService) CreateObjectStorageAccessKey(ctx context.Context, uuid string, username string) (*ObjectStorageAccessKey, *http.Response, error) {
+	u := fmt.Sprintf("object-storage-2/%v/users/%v/access-keys", uuid, username)
+	req, err := s.client.NewRequest("POST", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	key := new(ObjectStorageAccessKey)
+	resp, err := s.client.Do(ctx, req, key)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return key, resp, nil
+}
+
+// ModifyObjectStorageAccessKey sets the status of an access key to Active or Inactive.
+// https://developers.upcloud.com/1.3/21-managed-object-storage/#modify-access-key
+func (s *ObjectStorageService) ModifyObjectStorageAccessKey(ctx context.Context, uuid string, username string, accessKeyID string, status string) (*ObjectStorageAccessKey, *http.Response, error) {
+	u := fmt.Sprintf("object-storage-2/%v/users/%v/access-keys/%v", uuid, username, accessKeyID)
+	body := &struct {
+		Status string `json:"status"`
+	}{status}
+	req, err := s.client.NewRequest("PATCH", u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	key := new(ObjectStorageAccessKey)
+	resp, err := s.client.Do(ctx, req, key)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return key, resp, nil
+}
+
+// DeleteObjectStorageAccessKey revokes an access key.
+// https://developers.upcloud.com/1.3/21-managed-object-storage/#delete-access-key
+func (s *ObjectStorageService) DeleteObjectStorageAccessKey(ctx context.Context, uuid string, username string, accessKeyID string) (*http.Response, error) {
+	u := fmt.Sprintf("object-storage-2/%v/users/%v/access-keys/%v", uuid, username, accessKeyID)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// ListObjectStorageBuckets returns the buckets of the object storage instance with the given uuid along with their usage.
+// https://developers.upcloud.com/1.3/21-managed-object-storage/#list-bucket-metrics
+func (s *ObjectStorageService) ListObjectStorageBuckets(ctx context.Context, uuid string) ([]ObjectStorageBucket, *http.Response, error) {
+	u := fmt.Sprintf("object-storage-2/%v/metrics/buckets", uuid)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var buckets []ObjectStorageBucket
+	resp, err := s.client.Do(ctx, req, &buckets)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return buckets, resp, nil
+}
+
+// GetObjectStorageMetrics returns the usage statistics of the object storage instance with the given uuid.
+// https://developers.upcloud.com/1.3/21-managed-object-storage/#get-service-metrics
+func (s *ObjectStorageService) GetObjectStorageMetrics(ctx context.Context, uuid string) (*ObjectStorageMetrics, *http.Response, error) {
+	u := fmt.Sprintf("object-storage-2/%v/metrics", uuid)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	metrics := new(ObjectStorageMetrics)
+	resp, err := s.client.Do(ctx, req, metrics)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return metrics, resp, nil
+}
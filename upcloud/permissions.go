@@ -0,0 +1,92 @@
+package upcloud
+
+import (
+	"context"
+	"net/http"
+)
+
+// PermissionsService handles communication with the permission related methods of the UpCloud API
+// https://developers.upcloud.com/1.3/18-permissions/
+type PermissionsService service
+
+// Permission target types
+const (
+	PermissionTargetServer  = "server"
+	PermissionTargetStorage = "storage"
+	PermissionTargetNetwork = "network"
+	PermissionTargetRouter  = "router"
+	PermissionTargetTag     = "tag"
+)
+
+// PermissionOptions represents the options of a permission
+type PermissionOptions struct {
+	Storage string `json:"storage,omitempty"` //yes/no, grants access to the storages of a server or tag
+}
+
+// Permission represents access of a sub account to a single resource
+type Permission struct {
+	TargetIdentifier string             `json:"target_identifier"` //UUID or name of the target
+	TargetType       string             `json:"target_type"`       //server/storage/network/router/tag
+	User             string             `json:"user"`
+	Options          *PermissionOptions `json:"options,omitempty"`
+}
+
+// PermissionList represents the list of permissions
+type PermissionList struct {
+	Permissions []Permission `json:"permission"`
+}
+
+// PermissionListResponse represents the response from the ListPermissions API call
+type PermissionListResponse struct {
+	PermissionList *PermissionList `json:"permissions"`
+}
+
+// PermissionResponse represents the request/response wrapper for a single permission
+type PermissionResponse struct {
+	Permission *Permission `json:"permission"`
+}
+
+// ListPermissions returns the permissions granted to the sub accounts of the account.
+// https://developers.upcloud.com/1.3/18-permissions/#list-permissions
+func (s *PermissionsService) ListPermissions(ctx context.Context) (*PermissionList, *http.Response, error) {
+	req, err := s.client.NewRequest("GET", "permission", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	permissionList := new(PermissionList)
+	resp, err := s.client.Do(ctx, req, &PermissionListResponse{PermissionList: permissionList})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return permissionList, resp, nil
+}
+
+// GrantPermission grants the sub account p.User access to a single target, leaving its other permissions unchanged.
+// https://developers.upcloud.com/1.3/18-permissions/#grant-permission
+func (s *PermissionsService) GrantPermission(ctx context.Context, p *Permission) (*Permission, *http.Response, error) {
+	req, err := s.client.NewRequest("POST", "permission/grant", &PermissionResponse{Permission: p})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	permission := new(Permission)
+	resp, err := s.client.Do(ctx, req, &PermissionResponse{Permission: permission})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return permission, resp, nil
+}
+
+// RevokePermission revokes the access of the sub account p.User to a single target, leaving its other permissions unchanged.
+// https://developers.upcloud.com/1.3/18-permissions/#revoke-permission
+func (s *PermissionsService) RevokePermission(ctx context.Context, p *Permission) (*http.Response, error) {
+	req, err := s.client.NewRequest("POST", "permission/revoke", &PermissionResponse{Permission: p})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -2,7 +2,16 @@ package upcloud
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
+	"strings"
+)
+
+const (
+	objectStoragePricePrefix    = "object_storage_"
+	serverPlanPricePrefix       = "server_plan_"
+	simpleBackupPricePrefix     = "simple_backup_"
+	simpleBackupPlanPricePrefix = "simple_backup_plan_"
 )
 
 // PricingService handles communication with the pricing related methods of the UpCloud API
@@ -28,14 +37,64 @@ type ZonePricing struct {
 	PublicIpv4BandwidthOut UnitPrice `json:"public_ipv4_bandwidth_out"`
 	PublicIPv6BandwidthIn  UnitPrice `json:"public_ipv6_bandwidth_in"`
 	PublicIPv6BandwidthOut UnitPrice `json:"public_ipv6_bandwidth_out"`
-	ServerCore             UnitPrice `json:"server_code"`
+	ServerCore             UnitPrice `json:"server_core"`
 	ServerMemory           UnitPrice `json:"server_memory"`
 	StorageBackup          UnitPrice `json:"storage_backup"`
 	StorageHDD             UnitPrice `json:"storage_hdd"`
 	StorageMaxIOPS         UnitPrice `json:"storage_maxiops"`
 	StorageTemplate        UnitPrice `json:"storage_template"`
-	// Server Plans
-	// Simple backup plans
+	// Object storage, keyed by size in GiB e.g. "250"
+	ObjectStorage map[string]UnitPrice `json:"-"`
+	// Server plans, keyed by plan name e.g. "1xCPU-1GB"
+	ServerPlans map[string]UnitPrice `json:"-"`
+	// Simple backup plans, keyed by plan name e.g. "dailies"
+	SimpleBackupPlans map[string]UnitPrice `json:"-"`
+}
+
+// UnmarshalJSON decodes the fixed price fields and collects the object storage,
+// server plan and simple backup plan prices.
+func (p *ZonePricing) UnmarshalJSON(data []byte) error {
+	type zonePricing ZonePricing
+	if err := json.Unmarshal(data, (*zonePricing)(p)); err != nil {
+		return err
+	}
+
+	var prices map[string]json.RawMessage
+	if err := json.Unmarshal(data, &prices); err != nil {
+		return err
+	}
+
+	for key, raw := range prices {
+		var m *map[string]UnitPrice
+		var name string
+		switch {
+		case strings.HasPrefix(key, objectStoragePricePrefix):
+			m, name = &p.ObjectStorage, strings.TrimPrefix(key, objectStoragePricePrefix)
+		case strings.HasPrefix(key, serverPlanPricePrefix):
+			m, name = &p.ServerPlans, strings.TrimPrefix(key, serverPlanPricePrefix)
+		case strings.HasPrefix(key, simpleBackupPlanPricePrefix):
+			m, name = &p.SimpleBackupPlans, strings.TrimPrefix(key, simpleBackupPlanPricePrefix)
+		case strings.HasPrefix(key, simpleBackupPricePrefix):
+			m, name = &p.SimpleBackupPlans, strings.TrimPrefix(key, simpleBackupPricePrefix)
+		default:
+			continue
+		}
+		var price UnitPrice
+		if err := json.Unmarshal(raw, &price); err != nil {
+			return err
+		}
+		if *m == nil {
+			*m = make(map[string]UnitPrice)
+		}
+		(*m)[name] = price
+	}
+	return nil
+}
+
+// PlanPrice returns the price of the server plan with the given name, e.g. the Name of a Plan.
+func (p *ZonePricing) PlanPrice(plan string) (UnitPrice, bool) {
+	price, ok := p.ServerPlans[plan]
+	return price, ok
 }
 
 // PriceList represents the list of prices for each zone
@@ -0,0 +1,95 @@
+package upcloud
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Limiter delays requests sent by Client.Do, Wait blocks until a request may be sent or ctx expires.
+type Limiter interface {
+	Wait(ctx context.Context) error
+}
+
+// RateLimiter is a token bucket Limiter allowing rate requests per second with bursts of up to burst requests.
+type RateLimiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter returns a RateLimiter with a full bucket of burst tokens refilled at rate tokens per second.
+// A rate of 0 or less does not limit requests.
+func NewRateLimiter(rate float64, burst int) *RateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &RateLimiter{rate: rate, burst: float64(burst), tokens: float64(burst), last: time.Now()}
+}
+
+// Wait takes a token from the bucket, blocking until one is available or ctx expires.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	if l.rate <= 0 {
+		return ctx.Err()
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+
+	// Reserve the token now so concurrent callers queue behind each other
+	l.tokens--
+	var d time.Duration
+	if l.tokens < 0 {
+		d = time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+	l.mu.Unlock()
+
+	if d == 0 {
+		return nil
+	}
+	if err := sleep(ctx, d); err != nil {
+		// Return the unused token
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// SetMaxInFlight limits the number of requests Client.Do sends concurrently, n less than 1 removes the limit.
+// It must be called before the Client is used.
+func (c *Client) SetMaxInFlight(n int) {
+	if n < 1 {
+		c.inFlight = nil
+		return
+	}
+	c.inFlight = make(chan struct{}, n)
+}
+
+// acquire waits for the Limiter and a free in flight slot, the returned func releases the slot.
+func (c *Client) acquire(ctx context.Context) (func(), error) {
+	if c.Limiter != nil {
+		if err := c.Limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+	// Release into the same channel even if SetMaxInFlight replaces it meanwhile
+	ch := c.inFlight
+	if ch == nil {
+		return func() {}, nil
+	}
+	select {
+	case ch <- struct{}{}:
+		return func() { <-ch }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
@@ -0,0 +1,151 @@
+package upcloud
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how Client.Do retries failed requests.
+// Only idempotent methods are retried, POST requests are retried only when rejected with 429 Too Many Requests.
+// Requests whose body cannot be rewound are never retried, nor are responses whose Retry-After exceeds MaxBackoff.
+type RetryPolicy struct {
+	MaxAttempts         int           // Total number of attempts including the first, less than 2 disables retries
+	MinBackoff          time.Duration // Delay before the first retry, doubled on each further retry
+	MaxBackoff          time.Duration // Upper bound of the delay between retries, 0 for no bound
+	RetryableStatuses   []int         // HTTP status codes that are retried
+	RetryableErrorCodes []string      // UpCloud error codes that are retried regardless of status
+}
+
+// DefaultRetryPolicy returns a RetryPolicy retrying rate limited and temporarily unavailable requests.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 4,
+		MinBackoff:  500 * time.Millisecond,
+		MaxBackoff:  10 * time.Second,
+		RetryableStatuses: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+// retryable reports whether req may be attempted again after attempt attempts.
+// resp and errResp are nil when the attempt failed without a response.
+func (p *RetryPolicy) retryable(req *http.Request, attempt int, resp *http.Response, errResp *ErrorResponse) bool {
+	if p == nil || attempt >= p.MaxAttempts {
+		return false
+	}
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+
+	switch req.Method {
+	case "GET", "HEAD", "OPTIONS", "PUT", "DELETE":
+	case "POST":
+		return resp != nil && resp.StatusCode == http.StatusTooManyRequests
+	default:
+		return false
+	}
+
+	if resp == nil {
+		return true
+	}
+	for _, s := range p.RetryableStatuses {
+		if resp.StatusCode == s {
+			return true
+		}
+	}
+	if errResp != nil && errResp.APIError != nil {
+		for _, code := range p.RetryableErrorCodes {
+			if errResp.APIError.Code == code {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// backoff returns the delay before the retry following attempt.
+// A Retry-After header on resp takes precedence over the exponential backoff,
+// false is returned if it asks for a longer delay than MaxBackoff so the request is not retried.
+func (p *RetryPolicy) backoff(attempt int, resp *http.Response) (time.Duration, bool) {
+	if resp != nil {
+		if d, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+			if p.MaxBackoff > 0 && d > p.MaxBackoff {
+				return 0, false
+			}
+			return d, true
+		}
+	}
+
+	d := p.MinBackoff
+	if d <= 0 {
+		return 0, true
+	}
+	for i := 1; i < attempt; i++ {
+		if p.MaxBackoff > 0 && d >= p.MaxBackoff {
+			break
+		}
+		if d > math.MaxInt64/2 {
+			break
+		}
+		d *= 2
+	}
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	// Equal jitter, half fixed and half random
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1)), true
+}
+
+// retryAfter parses a Retry-After header given either in seconds or as an HTTP date
+func retryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if s, err := strconv.Atoi(v); err == nil {
+		if s < 0 {
+			return 0, false
+		}
+		return time.Duration(s) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+	return 0, false
+}
+
+// rewind resets the body of req for another attempt
+func rewind(req *http.Request) error {
+	if req.GetBody == nil {
+		return nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return err
+	}
+	req.Body = body
+	return nil
+}
+
+// sleep waits for d or until ctx expires
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
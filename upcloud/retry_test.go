@@ -0,0 +1,216 @@
+package upcloud
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newTestClient returns a Client sending requests to handler with fast retries enabled
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	c := NewClient(nil)
+	c.BaseURL, _ = url.Parse(srv.URL + "/")
+	c.RetryPolicy = &RetryPolicy{
+		MaxAttempts:       3,
+		MinBackoff:        time.Millisecond,
+		MaxBackoff:        5 * time.Millisecond,
+		RetryableStatuses: []int{http.StatusTooManyRequests, http.StatusServiceUnavailable},
+	}
+	return c
+}
+
+func TestBackoffDoublesWithoutMaxBackoff(t *testing.T) {
+	p := &RetryPolicy{MinBackoff: time.Second}
+	for attempt, want := range map[int]time.Duration{1: time.Second, 2: 2 * time.Second, 4: 8 * time.Second} {
+		d, _ := p.backoff(attempt, nil)
+		if d < want/2 || d > want {
+			t.Errorf("backoff(%d) = %v, want between %v and %v", attempt, d, want/2, want)
+		}
+	}
+}
+
+func TestBackoffCappedByMaxBackoff(t *testing.T) {
+	p := &RetryPolicy{MinBackoff: time.Second, MaxBackoff: 3 * time.Second}
+	if d, _ := p.backoff(10, nil); d > 3*time.Second {
+		t.Errorf("backoff(10) = %v, want at most %v", d, 3*time.Second)
+	}
+}
+
+func TestDoRetriesServerErrors(t *testing.T) {
+	for _, method := range []string{"GET", "PUT"} {
+		t.Run(method, func(t *testing.T) {
+			var calls int
+			var bodies []string
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				b, _ := ioutil.ReadAll(r.Body)
+				bodies = append(bodies, string(b))
+				if calls < 3 {
+					w.WriteHeader(http.StatusServiceUnavailable)
+					return
+				}
+				w.Write([]byte(`{"name":"ok"}`))
+			})
+
+			var body interface{}
+			if method == "PUT" {
+				body = map[string]string{"name": "tag"}
+			}
+			req, err := c.NewRequest(method, "tag/x", body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var v struct {
+				Name string `json:"name"`
+			}
+			if _, err := c.Do(context.Background(), req, &v); err != nil {
+				t.Fatalf("Do returned error: %v", err)
+			}
+			if calls != 3 {
+				t.Errorf("server called %d times, want 3", calls)
+			}
+			if v.Name != "ok" {
+				t.Errorf("decoded name %q, want %q", v.Name, "ok")
+			}
+			for i, b := range bodies {
+				if b != bodies[0] {
+					t.Errorf("attempt %d body %q, want rewound body %q", i+1, b, bodies[0])
+				}
+			}
+		})
+	}
+}
+
+func TestDoDoesNotReplayPOSTOnServerError(t *testing.T) {
+	var calls int
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	req, _ := c.NewRequest("POST", "tag", map[string]string{"name": "tag"})
+	_, err := c.Do(context.Background(), req, nil)
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Fatalf("Do returned %v, want *ErrorResponse", err)
+	}
+	if calls != 1 {
+		t.Errorf("server called %d times, want 1", calls)
+	}
+}
+
+func TestDoRetriesPOSTOnTooManyRequests(t *testing.T) {
+	var calls int
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	req, _ := c.NewRequest("POST", "tag", map[string]string{"name": "tag"})
+	if _, err := c.Do(context.Background(), req, nil); err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("server called %d times, want 2", calls)
+	}
+}
+
+func TestDoDoesNotRetryUnrewindableBody(t *testing.T) {
+	var calls int
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	// A plain io.Reader has no GetBody
+	r := struct{ *strings.Reader }{strings.NewReader("data")}
+	req, _ := c.NewUploadRequest("PUT", "upload", r, 4, "")
+	if _, err := c.Do(context.Background(), req, nil); err == nil {
+		t.Fatal("Do returned no error")
+	}
+	if calls != 1 {
+		t.Errorf("server called %d times, want 1", calls)
+	}
+}
+
+func TestDoHonoursRetryAfter(t *testing.T) {
+	var calls int
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+	})
+	c.RetryPolicy.MaxBackoff = 2 * time.Second
+
+	req, _ := c.NewRequest("GET", "tag", nil)
+	start := time.Now()
+	if _, err := c.Do(context.Background(), req, nil); err != nil {
+		t.Fatalf("Do returned error: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("retried after %v, want at least 1s from Retry-After", elapsed)
+	}
+	if calls != 2 {
+		t.Errorf("server called %d times, want 2", calls)
+	}
+}
+
+func TestDoDoesNotWaitForRetryAfterBeyondMaxBackoff(t *testing.T) {
+	var calls int
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	req, _ := c.NewRequest("GET", "tag", nil)
+	start := time.Now()
+	_, err := c.Do(context.Background(), req, nil)
+	var errResp *ErrorResponse
+	if !errors.As(err, &errResp) {
+		t.Fatalf("Do returned %v, want *ErrorResponse", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Do took %v, want it to return without waiting for Retry-After", elapsed)
+	}
+	if calls != 1 {
+		t.Errorf("server called %d times, want 1", calls)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	tests := []struct {
+		value string
+		want  time.Duration
+		ok    bool
+	}{
+		{"", 0, false},
+		{"3", 3 * time.Second, true},
+		{"-1", 0, false},
+		{"soon", 0, false},
+		{time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat), 0, true},
+	}
+	for _, tt := range tests {
+		got, ok := retryAfter(tt.value)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("retryAfter(%q) = %v, %v, want %v, %v", tt.value, got, ok, tt.want, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,173 @@
+package upcloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// RoutersService handles communication with the router related methods of the UpCloud API
+// https://developers.upcloud.com/1.3/13-networks/
+type RoutersService service
+
+// RouterNetwork represents a network attached to a router
+type RouterNetwork struct {
+	UUID string `json:"uuid"`
+}
+
+// RouterNetworkList represents the list of networks attached to a router
+type RouterNetworkList struct {
+	Networks []RouterNetwork `json:"network"`
+}
+
+// Router represents the properties of a router
+type Router struct {
+	AttachedNetworks RouterNetworkList `json:"attached_networks"`
+	Name             string            `json:"name"`
+	Type             string            `json:"type,omitempty"`
+	UUID             string            `json:"uuid,omitempty"`
+}
+
+// RouterList represents the list of routers
+type RouterList struct {
+	Routers []Router `json:"router"`
+}
+
+// RouterListResponse represents the response from the ListRouters API call
+type RouterListResponse struct {
+	RouterList *RouterList `json:"routers"`
+}
+
+// RouterDetailsResponse represents the response from router details API methods
+type RouterDetailsResponse struct {
+	Router *Router `json:"router"`
+}
+
+// ListRouters returns the routers on the account.
+// https://developers.upcloud.com/1.3/13-networks/#list-routers
+func (s *RoutersService) ListRouters(ctx context.Context) (*RouterList, *http.Response, error) {
+	req, err := s.client.NewRequest("GET", "router", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	routerList := new(RouterList)
+	resp, err := s.client.Do(ctx, req, &RouterListResponse{RouterList: routerList})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return routerList, resp, nil
+}
+
+// GetRouterDetails returns the properties of the router with the given uuid.
+// https://developers.upcloud.com/1.3/13-networks/#get-router-details
+func (s *RoutersService) GetRouterDetails(ctx context.Context, uuid string) (*Router, *http.Response, error) {
+	u := fmt.Sprintf("router/%v", uuid)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	router := new(Router)
+	resp, err := s.client.Do(ctx, req, &RouterDetailsResponse{Router: router})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return router, resp, nil
+}
+
+// routerRequest represents the properties of a router that can be set on create and modify
+type routerRequest struct {
+	Name string `json:"name"`
+}
+
+type routerRequestWrapper struct {
+	Router *routerRequest `json:"router"`
+}
+
+// CreateRouter creates a new router with the given name.
+// https://developers.upcloud.com/1.3/13-networks/#create-router
+func (s *RoutersService) CreateRouter(ctx context.Context, name string) (*Router, *http.Response, error) {
+	req, err := s.client.NewRequest("POST", "router", &routerRequestWrapper{Router: &routerRequest{Name: name}})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	router := new(Router)
+	resp, err := s.client.Do(ctx, req, &RouterDetailsResponse{Router: router})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return router, resp, nil
+}
+
+// ModifyRouter renames the router with the given uuid.
+// https://developers.upcloud.com/1.3/13-networks/#modify-router
+func (s *RoutersService) ModifyRouter(ctx context.Context, uuid string, name string) (*Router, *http.Response, error) {
+	u := fmt.Sprintf("router/%v", uuid)
+	req, err := s.client.NewRequest("PUT", u, &routerRequestWrapper{Router: &routerRequest{Name: name}})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	router := new(Router)
+	resp, err := s.client.Do(ctx, req, &RouterDetailsResponse{Router: router})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return router, resp, nil
+}
+
+// DeleteRouter deletes the router with the given uuid, no networks may be attached to it.
+// https://developers.upcloud.com/1.3/13-networks/#delete-router
+func (s *RoutersService) DeleteRouter(ctx context.Context, uuid string) (*http.Response, error) {
+	u := fmt.Sprintf("router/%v", uuid)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// networkRouterRequest sets or clears the router of a network, a nil Router detaches it
+type networkRouterRequest struct {
+	Router *string `json:"router"`
+}
+
+type networkRouterRequestWrapper struct {
+	Network *networkRouterRequest `json:"network"`
+}
+
+// AttachNetwork attaches the SDN private network with the given uuid to a router.
+// https://developers.upcloud.com/1.3/13-networks/#attach-router-to-network
+func (s *RoutersService) AttachNetwork(ctx context.Context, routerUUID string, networkUUID string) (*Network, *http.Response, error) {
+	return s.setNetworkRouter(ctx, networkUUID, &routerUUID)
+}
+
+// DetachNetwork detaches the SDN private network with the given uuid from its router.
+// https://developers.upcloud.com/1.3/13-networks/#detach-router-from-network
+func (s *RoutersService) DetachNetwork(ctx context.Context, networkUUID string) (*Network, *http.Response, error) {
+	return s.setNetworkRouter(ctx, networkUUID, nil)
+}
+
+func (s *RoutersService) setNetworkRouter(ctx context.Context, networkUUID string, routerUUID *string) (*Network, *http.Response, error) {
+	u := fmt.Sprintf("network/%v", networkUUID)
+	body := &networkRouterRequestWrapper{Network: &networkRouterRequest{Router: routerUUID}}
+	req, err := s.client.NewRequest("PUT", u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	network := new(Network)
+	resp, err := s.client.Do(ctx, req, &NetworkDetailsResponse{Network: network})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return network, resp, nil
+}
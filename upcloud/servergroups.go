@@ -0,0 +1,199 @@
+package upcloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// ServerGroupsService handles communication with the server group related methods of the UpCloud API
+// https://developers.upcloud.com/1.3/8-servers/#server-groups
+type ServerGroupsService service
+
+// Anti-affinity policies of a server group
+const (
+	AntiAffinityPolicyStrict = "strict" //Members must be on different hosts, starting a server fails otherwise
+	AntiAffinityPolicyYes    = "yes"    //Members are placed on different hosts on a best effort basis
+	AntiAffinityPolicyNo     = "no"
+)
+
+// Anti-affinity status of a server group member
+const (
+	AntiAffinityStatusMet   = "met"
+	AntiAffinityStatusUnmet = "unmet"
+)
+
+// ServerGroupServerList represents the list of server UUIDs in a server group
+type ServerGroupServerList struct {
+	Servers []string `json:"server"`
+}
+
+// ServerGroupMemberStatus represents whether the anti-affinity policy is met for a member server
+type ServerGroupMemberStatus struct {
+	UUID   string `json:"uuid"`
+	Status string `json:"status"` //met/unmet
+}
+
+// ServerGroup represents a group of servers
+type ServerGroup struct {
+	AntiAffinity       string                    `json:"anti_affinity"` //strict/yes/no
+	AntiAffinityStatus []ServerGroupMemberStatus `json:"anti_affinity_status,omitempty"`
+	Servers            ServerGroupServerList     `json:"servers"`
+	Title              string                    `json:"title"`
+	UUID               string                    `json:"uuid"`
+}
+
+// ServerGroupList represents the list of server groups
+type ServerGroupList struct {
+	ServerGroups []ServerGroup `json:"server_group"`
+}
+
+// ServerGroupListResponse represents the response from the ListServerGroups API call
+type ServerGroupListResponse struct {
+	ServerGroupList *ServerGroupList `json:"server_groups"`
+}
+
+// ServerGroupDetailsResponse represents the response from server group details API methods
+type ServerGroupDetailsResponse struct {
+	ServerGroup *ServerGroup `json:"server_group"`
+}
+
+// ListServerGroups returns the server groups on the account.
+// https://developers.upcloud.com/1.3/8-servers/#list-server-groups
+func (s *ServerGroupsService) ListServerGroups(ctx context.Context) (*ServerGroupList, *http.Response, error) {
+	req, err := s.client.NewRequest("GET", "server-group", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	groupList := new(ServerGroupList)
+	resp, err := s.client.Do(ctx, req, &ServerGroupListResponse{ServerGroupList: groupList})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return groupList, resp, nil
+}
+
+// GetServerGroupDetails returns the server group with the given uuid.
+// https://developers.upcloud.com/1.3/8-servers/#get-server-group-details
+func (s *ServerGroupsService) GetServerGroupDetails(ctx context.Context, uuid string) (*ServerGroup, *http.Response, error) {
+	u := fmt.Sprintf("server-group/%v", uuid)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	group := new(ServerGroup)
+	resp, err := s.client.Do(ctx, req, &ServerGroupDetailsResponse{ServerGroup: group})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return group, resp, nil
+}
+
+// ServerGroupRequest represents the properties of a server group to create or modify
+type ServerGroupRequest struct {
+	Title        string                 `json:"title,omitempty"`
+	AntiAffinity string                 `json:"anti_affinity,omitempty"` //strict/yes/no
+	Servers      *ServerGroupServerList `json:"servers,omitempty"`       //Replaces all members on modify
+}
+
+type serverGroupRequestWrapper struct {
+	ServerGroup *ServerGroupRequest `json:"server_group"`
+}
+
+// CreateServerGroup creates a new server group with the properties defined in r.
+// https://developers.upcloud.com/1.3/8-servers/#create-server-group
+func (s *ServerGroupsService) CreateServerGroup(ctx context.Context, r *ServerGroupRequest) (*ServerGroup, *http.Response, error) {
+	req, err := s.client.NewRequest("POST", "server-group", &serverGroupRequestWrapper{ServerGroup: r})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	group := new(ServerGroup)
+	resp, err := s.client.Do(ctx, req, &ServerGroupDetailsResponse{ServerGroup: group})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return group, resp, nil
+}
+
+// ModifyServerGroup modifies the server group with the given uuid using the properties defined in r.
+// https://developers.upcloud.com/1.3/8-servers/#modify-server-group
+func (s *ServerGroupsService) ModifyServerGroup(ctx context.Context, uuid string, r *ServerGroupRequest) (*ServerGroup, *http.Response, error) {
+	u := fmt.Sprintf("server-group/%v", uuid)
+	req, err := s.client.NewRequest("PATCH", u, &serverGroupRequestWrapper{ServerGroup: r})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	group := new(ServerGroup)
+	resp, err := s.client.Do(ctx, req, &ServerGroupDetailsResponse{ServerGroup: group})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return group, resp, nil
+}
+
+// SetAntiAffinityPolicy sets the anti-affinity policy of the server group with the given uuid.
+func (s *ServerGroupsService) SetAntiAffinityPolicy(ctx context.Context, uuid string, policy string) (*ServerGroup, *http.Response, error) {
+	return s.ModifyServerGroup(ctx, uuid, &ServerGroupRequest{AntiAffinity: policy})
+}
+
+// GetAntiAffinityStatus returns whether the anti-affinity policy is met for each member of the server group.
+func (s *ServerGroupsService) GetAntiAffinityStatus(ctx context.Context, uuid string) ([]ServerGroupMemberStatus, *http.Response, error) {
+	group, resp, err := s.GetServerGroupDetails(ctx, uuid)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return group.AntiAffinityStatus, resp, nil
+}
+
+// DeleteServerGroup deletes the server group with the given uuid, member servers are not affected.
+// https://developers.upcloud.com/1.3/8-servers/#delete-server-group
+func (s *ServerGroupsService) DeleteServerGroup(ctx context.Context, uuid string) (*http.Response, error) {
+	u := fmt.Sprintf("server-group/%v", uuid)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+type serverGroupMemberRequestWrapper struct {
+	Server struct {
+		UUID string `json:"uuid"`
+	} `json:"server"`
+}
+
+// AddServerGroupMember adds the server with the given uuid to a server group.
+// https://developers.upcloud.com/1.3/8-servers/#add-server-to-a-server-group
+func (s *ServerGroupsService) AddServerGroupMember(ctx context.Context, groupUUID string, serverUUID string) (*http.Response, error) {
+	u := fmt.Sprintf("server-group/%v/servers", groupUUID)
+	body := new(serverGroupMemberRequestWrapper)
+	body.Server.UUID = serverUUID
+	req, err := s.client.NewRequest("POST", u, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// RemoveServerGroupMember removes the server with the given uuid from a server group.
+// https://developers.upcloud.com/1.3/8-servers/#remove-server-from-a-server-group
+func (s *ServerGroupsService) RemoveServerGroupMember(ctx context.Context, groupUUID string, serverUUID string) (*http.Response, error) {
+	u := fmt.Sprintf("server-group/%v/servers/%v", groupUUID, serverUUID)
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,436 @@
+package upcloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// ServersService handles communication with the server related methods of the UpCloud API
+// https://developers.upcloud.com/1.3/8-servers/
+type ServersService service
+
+// Server states
+const (
+	ServerStateStarted     = "started"
+	ServerStateStopped     = "stopped"
+	ServerStateMaintenance = "maintenance"
+	ServerStateError       = "error"
+)
+
+// Stop types used when stopping or restarting a server
+const (
+	StopTypeSoft = "soft"
+	StopTypeHard = "hard"
+)
+
+// Storage device actions used when creating a server
+const (
+	StorageDeviceActionCreate = "create"
+	StorageDeviceActionClone  = "clone"
+	StorageDeviceActionAttach = "attach"
+)
+
+// Backup handling when deleting a server together with its storages
+const (
+	DeleteBackupsKeep       = "keep"
+	DeleteBackupsKeepLatest = "keep_latest"
+	DeleteBackupsDelete     = "delete"
+)
+
+// ServerTags represents the list of tags assigned to a server
+type ServerTags struct {
+	Tags []string `json:"tag"`
+}
+
+// Server represents a server in the server list
+type Server struct {
+	CoreNumber   int        `json:"core_number,string"`
+	Hostname     string     `json:"hostname"`
+	License      float64    `json:"license"`
+	MemoryAmount int        `json:"memory_amount,string"` //MiB
+	Plan         string     `json:"plan"`
+	State        string     `json:"state"` //started/stopped/maintenance/error
+	Tags         ServerTags `json:"tags"`
+	Title        string     `json:"title"`
+	UUID         string     `json:"uuid"`
+	Zone         string     `json:"zone"`
+}
+
+// ServerList represents the list of servers
+type ServerList struct {
+	Servers []Server `json:"server"`
+}
+
+// ServerListResponse represents the response from the ListServers API call
+type ServerListResponse struct {
+	ServerList *ServerList `json:"servers"`
+}
+
+// ServerIPAddress represents an IP address attached to a server
+type ServerIPAddress struct {
+	Access  string `json:"access"` //public/private/utility
+	Address string `json:"address,omitempty"`
+	Family  string `json:"family"` //IPv4/IPv6
+}
+
+// ServerIPAddressList represents the list of IP addresses attached to a server
+type ServerIPAddressList struct {
+	IPAddresses []ServerIPAddress `json:"ip_address"`
+}
+
+// ServerStorageDevice represents a storage device attached to a server
+type ServerStorageDevice struct {
+	Address      string `json:"address"`
+	BootDisk     string `json:"boot_disk"` //0/1
+	PartOfPlan   string `json:"part_of_plan"`
+	Storage      string `json:"storage"`
+	StorageSize  int    `json:"storage_size"` //GiB
+	StorageTitle string `json:"storage_title"`
+	Type         string `json:"type"` //disk/cdrom
+}
+
+// ServerStorageDeviceList represents the list of storage devices attached to a server
+type ServerStorageDeviceList struct {
+	StorageDevices []ServerStorageDevice `json:"storage_device"`
+}
+
+// ServerDetails represents the full description of a server
+type ServerDetails struct {
+	Server
+	BootOrder      string                  `json:"boot_order"`
+	Firewall       string                  `json:"firewall"` //on/off
+	Host           int                     `json:"host"`
+	IPAddresses    ServerIPAddressList     `json:"ip_addresses"`
+	Metadata       string                  `json:"metadata"` //yes/no
+	Networking     *ServerNetworking       `json:"networking,omitempty"`
+	NICModel       string                  `json:"nic_model"`
+	SimpleBackup   string                  `json:"simple_backup"`
+	StorageDevices ServerStorageDeviceList `json:"storage_devices"`
+	Timezone       string                  `json:"timezone"`
+	VideoModel     string                  `json:"video_model"`
+	VNC            string                  `json:"vnc"` //on/off
+	VNCHost        string                  `json:"vnc_host"`
+	VNCPassword    string                  `json:"vnc_password"`
+	VNCPort        string                  `json:"vnc_port"`
+}
+
+// ServerDetailsResponse represents the response from server details API methods
+type ServerDetailsResponse struct {
+	Server *ServerDetails `json:"server"`
+}
+
+// ListServers returns the list of servers on the account.
+// https://developers.upcloud.com/1.3/8-servers/#list-servers
+func (s *ServersService) ListServers(ctx context.Context) (*ServerList, *http.Response, error) {
+	req, err := s.client.NewRequest("GET", "server", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	serverList := new(ServerList)
+	resp, err := s.client.Do(ctx, req, &ServerListResponse{ServerList: serverList})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return serverList, resp, nil
+}
+
+// GetServerDetails returns the full description of the server with the given uuid.
+// https://developers.upcloud.com/1.3/8-servers/#get-server-details
+func (s *ServersService) GetServerDetails(ctx context.Context, uuid string) (*ServerDetails, *http.Response, error) {
+	u := fmt.Sprintf("server/%v", uuid)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	server := new(ServerDetails)
+	resp, err := s.client.Do(ctx, req, &ServerDetailsResponse{Server: server})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return server, resp, nil
+}
+
+// CreateServerStorageDevice represents a storage device to create, clone or attach on server creation
+type CreateServerStorageDevice struct {
+	Action  string `json:"action"`            //create/clone/attach
+	Address string `json:"address,omitempty"` //ide/scsi/virtio, optionally with bus number
+	Storage string `json:"storage,omitempty"` //UUID of the storage to clone or attach
+	Size    int    `json:"size,omitempty"`    //GiB
+	Tier    string `json:"tier,omitempty"`    //hdd/maxiops
+	Title   string `json:"title,omitempty"`
+	Type    string `json:"type,omitempty"` //disk/cdrom
+}
+
+// CreateServerStorageDeviceList represents the list of storage devices on server creation
+type CreateServerStorageDeviceList struct {
+	StorageDevices []CreateServerStorageDevice `json:"storage_device"`
+}
+
+// CreateServerIPAddress represents an IP address to assign on server creation
+type CreateServerIPAddress struct {
+	Access string `json:"access"`           //public/private/utility
+	Family string `json:"family,omitempty"` //IPv4/IPv6
+}
+
+// CreateServerIPAddressList represents the list of IP addresses on server creation
+type CreateServerIPAddressList struct {
+	IPAddresses []CreateServerIPAddress `json:"ip_address"`
+}
+
+// SSHKeyList represents the list of SSH public keys for a login user
+type SSHKeyList struct {
+	SSHKeys []string `json:"ssh_key"`
+}
+
+// LoginUser represents the user created on the server during initialization
+type LoginUser struct {
+	CreatePassword string      `json:"create_password,omitempty"` //yes/no
+	Username       string      `json:"username,omitempty"`
+	SSHKeys        *SSHKeyList `json:"ssh_keys,omitempty"`
+}
+
+// CreateServerRequest represents the properties of a new server
+type CreateServerRequest struct {
+	Zone             string                        `json:"zone"`
+	Title            string                        `json:"title"`
+	Hostname         string                        `json:"hostname"`
+	Plan             string                        `json:"plan,omitempty"`
+	CoreNumber       int                           `json:"core_number,string,omitempty"`   //Only when Plan is custom or empty
+	MemoryAmount     int                           `json:"memory_amount,string,omitempty"` //MiB, only when Plan is custom or empty
+	AvoidHost        int                           `json:"avoid_host,omitempty"`
+	Host             int                           `json:"host,omitempty"`
+	BootOrder        string                        `json:"boot_order,omitempty"`
+	Firewall         string                        `json:"firewall,omitempty"` //on/off
+	Metadata         string                        `json:"metadata,omitempty"` //yes/no
+	NICModel         string                        `json:"nic_model,omitempty"`
+	PasswordDelivery string                        `json:"password_delivery,omitempty"` //none/email/sms
+	SimpleBackup     string                        `json:"simple_backup,omitempty"`
+	Timezone         string                        `json:"timezone,omitempty"`
+	VideoModel       string                        `json:"video_model,omitempty"`
+	StorageDevices   CreateServerStorageDeviceList `json:"storage_devices"`
+	IPAddresses      *CreateServerIPAddressList    `json:"ip_addresses,omitempty"`
+	LoginUser        *LoginUser                    `json:"login_user,omitempty"`
+	UserData         string                        `json:"user_data,omitempty"` //Script or URL to a script
+}
+
+type createServerRequestWrapper struct {
+	Server *CreateServerRequest `json:"server"`
+}
+
+// CreateServer creates a new server with the properties defined in r.
+// https://developers.upcloud.com/1.3/8-servers/#create-server
+func (s *ServersService) CreateServer(ctx context.Context, r *CreateServerRequest) (*ServerDetails, *http.Response, error) {
+	req, err := s.client.NewRequest("POST", "server", &createServerRequestWrapper{Server: r})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	server := new(ServerDetails)
+	resp, err := s.client.Do(ctx, req, &ServerDetailsResponse{Server: server})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return server, resp, nil
+}
+
+// ModifyServerRequest represents the properties of a server to modify, empty values are left unchanged
+type ModifyServerRequest struct {
+	Title        string `json:"title,omitempty"`
+	Hostname     string `json:"hostname,omitempty"`
+	Plan         string `json:"plan,omitempty"`
+	CoreNumber   int    `json:"core_number,string,omitempty"`
+	MemoryAmount int    `json:"memory_amount,string,omitempty"` //MiB
+	BootOrder    string `json:"boot_order,omitempty"`
+	Firewall     string `json:"firewall,omitempty"` //on/off
+	Metadata     string `json:"metadata,omitempty"` //yes/no
+	NICModel     string `json:"nic_model,omitempty"`
+	SimpleBackup string `json:"simple_backup,omitempty"`
+	Timezone     string `json:"timezone,omitempty"`
+	VideoModel   string `json:"video_model,omitempty"`
+	VNC          string `json:"vnc,omitempty"` //on/off
+	VNCPassword  string `json:"vnc_password,omitempty"`
+}
+
+type modifyServerRequestWrapper struct {
+	Server *ModifyServerRequest `json:"server"`
+}
+
+// ModifyServer modifies the server with the given uuid using the properties defined in r.
+// Plan, CoreNumber and MemoryAmount can only be changed while the server is stopped.
+// https://developers.upcloud.com/1.3/8-servers/#modify-server
+func (s *ServersService) ModifyServer(ctx context.Context, uuid string, r *ModifyServerRequest) (*ServerDetails, *http.Response, error) {
+	u := fmt.Sprintf("server/%v", uuid)
+	req, err := s.client.NewRequest("PUT", u, &modifyServerRequestWrapper{Server: r})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	server := new(ServerDetails)
+	resp, err := s.client.Do(ctx, req, &ServerDetailsResponse{Server: server})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return server, resp, nil
+}
+
+// DeleteServerOptions controls what is removed along with the server
+type DeleteServerOptions struct {
+	Storages bool   // Also delete the attached storages
+	Backups  string // keep/keep_latest/delete, only used when Storages is set
+}
+
+// DeleteServer deletes the server with the given uuid, the server must be stopped.
+// If opts is nil the attached storages are left untouched.
+// https://developers.upcloud.com/1.3/8-servers/#delete-server
+func (s *ServersService) DeleteServer(ctx context.Context, uuid string, opts *DeleteServerOptions) (*http.Response, error) {
+	u := fmt.Sprintf("server/%v", uuid)
+	if opts != nil && opts.Storages {
+		q := url.Values{}
+		q.Set("storages", "1")
+		if opts.Backups != "" {
+			q.Set("backups", opts.Backups)
+		}
+		u += "?" + q.Encode()
+	}
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// StartServer starts the stopped server with the given uuid.
+// https://developers.upcloud.com/1.3/8-servers/#start-server
+func (s *ServersService) StartServer(ctx context.Context, uuid string) (*ServerDetails, *http.Response, error) {
+	u := fmt.Sprintf("server/%v/start", uuid)
+	req, err := s.client.NewRequest("POST", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	server := new(ServerDetails)
+	resp, err := s.client.Do(ctx, req, &ServerDetailsResponse{Server: server})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return server, resp, nil
+}
+
+// StopServerRequest represents how a server should be stopped
+type StopServerRequest struct {
+	StopType string `json:"stop_type,omitempty"`      //soft/hard
+	Timeout  int    `json:"timeout,string,omitempty"` //Seconds before a soft stop becomes hard
+}
+
+type stopServerRequestWrapper struct {
+	StopServer *StopServerRequest `json:"stop_server"`
+}
+
+// StopServer stops the started server with the given uuid.
+// If r is nil the server is stopped with a soft stop.
+// https://developers.upcloud.com/1.3/8-servers/#stop-server
+func (s *ServersService) StopServer(ctx context.Context, uuid string, r *StopServerRequest) (*ServerDetails, *http.Response, error) {
+	if r == nil {
+		r = &StopServerRequest{StopType: StopTypeSoft}
+	}
+	u := fmt.Sprintf("server/%v/stop", uuid)
+	req, err := s.client.NewRequest("POST", u, &stopServerRequestWrapper{StopServer: r})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	server := new(ServerDetails)
+	resp, err := s.client.Do(ctx, req, &ServerDetailsResponse{Server: server})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return server, resp, nil
+}
+
+// RestartServerRequest represents how a server should be restarted
+type RestartServerRequest struct {
+	StopType      string `json:"stop_type,omitempty"`      //soft/hard
+	Timeout       int    `json:"timeout,string,omitempty"` //Seconds before TimeoutAction is taken
+	TimeoutAction string `json:"timeout_action,omitempty"` //destroy/ignore
+}
+
+type restartServerRequestWrapper struct {
+	RestartServer *RestartServerRequest `json:"restart_server"`
+}
+
+// RestartServer restarts the started server with the given uuid.
+// If r is nil the server is restarted with a soft stop.
+// https://developers.upcloud.com/1.3/8-servers/#restart-server
+func (s *ServersService) RestartServer(ctx context.Context, uuid string, r *RestartServerRequest) (*ServerDetails, *http.Response, error) {
+	if r == nil {
+		r = &RestartServerRequest{StopType: StopTypeSoft}
+	}
+	u := fmt.Sprintf("server/%v/restart", uuid)
+	req, err := s.client.NewRequest("POST", u, &restartServerRequestWrapper{RestartServer: r})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	server := new(ServerDetails)
+	resp, err := s.client.Do(ctx, req, &ServerDetailsResponse{Server: server})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return server, resp, nil
+}
+
+// CancelServerOperation cancels the ongoing operation of a server in maintenance state.
+// https://developers.upcloud.com/1.3/8-servers/#cancel-server-operation
+func (s *ServersService) CancelServerOperation(ctx context.Context, uuid string) (*ServerDetails, *http.Response, error) {
+	u := fmt.Sprintf("server/%v/cancel", uuid)
+	req, err := s.client.NewRequest("POST", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	server := new(ServerDetails)
+	resp, err := s.client.Do(ctx, req, &ServerDetailsResponse{Server: server})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return server, resp, nil
+}
+
+// WaitForServerState polls the server with the given uuid until it reaches state or ctx expires.
+// An error is returned if the server enters the error state while waiting for another state.
+func (s *ServersService) WaitForServerState(ctx context.Context, uuid, state string) (*ServerDetails, *http.Response, error) {
+	var server *ServerDetails
+	var resp *http.Response
+	err := poll(ctx, func() (bool, error) {
+		var err error
+		server, resp, err = s.GetServerDetails(ctx, uuid)
+		if err != nil {
+			return false, err
+		}
+		if server.State == state {
+			return true, nil
+		}
+		if server.State == ServerStateError {
+			return false, fmt.Errorf("server %v entered %v state while waiting for %v", uuid, ServerStateError, state)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return server, resp, nil
+}
@@ -0,0 +1,366 @@
+package upcloud
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// StorageService handles communication with the storage related methods of the UpCloud API
+// https://developers.upcloud.com/1.3/9-storages/
+type StorageService service
+
+// Storage types accepted by ListStorages
+const (
+	StorageTypePublic   = "public"
+	StorageTypePrivate  = "private"
+	StorageTypeNormal   = "normal"
+	StorageTypeBackup   = "backup"
+	StorageTypeCDROM    = "cdrom"
+	StorageTypeTemplate = "template"
+	StorageTypeFavorite = "favorite"
+)
+
+// Storage states
+const (
+	StorageStateOnline      = "online"
+	StorageStateMaintenance = "maintenance"
+	StorageStateCloning     = "cloning"
+	StorageStateBackuping   = "backuping"
+	StorageStateSyncing     = "syncing"
+	StorageStateError       = "error"
+)
+
+// Storage represents a storage in the storage list
+type Storage struct {
+	Access     string  `json:"access"` //public/private
+	Created    string  `json:"created,omitempty"`
+	License    float64 `json:"license"`
+	Origin     string  `json:"origin,omitempty"` //UUID of the storage a backup or template was created from
+	PartOfPlan string  `json:"part_of_plan,omitempty"`
+	Size       int     `json:"size"`  //GiB
+	State      string  `json:"state"` //online/maintenance/cloning/backuping/syncing/error
+	Tier       string  `json:"tier"`  //hdd/maxiops
+	Title      string  `json:"title"`
+	Type       string  `json:"type"` //normal/backup/cdrom/template
+	UUID       string  `json:"uuid"`
+	Zone       string  `json:"zone"`
+}
+
+// StorageList represents the list of storages
+type StorageList struct {
+	Storages []Storage `json:"storage"`
+}
+
+// StorageListResponse represents the response from the ListStorages API call
+type StorageListResponse struct {
+	StorageList *StorageList `json:"storages"`
+}
+
+// StorageBackupList represents the list of backup UUIDs of a storage
+type StorageBackupList struct {
+	Backups []string `json:"backup"`
+}
+
+// StorageServerList represents the list of server UUIDs a storage is attached to
+type StorageServerList struct {
+	Servers []string `json:"server"`
+}
+
+// StorageDetails represents the full description of a storage
+type StorageDetails struct {
+	Storage
+	BackupRule *BackupRule       `json:"backup_rule,omitempty"`
+	Backups    StorageBackupList `json:"backups"`
+	Servers    StorageServerList `json:"servers"`
+}
+
+// UnmarshalJSON decodes the storage details, leaving BackupRule nil when the API returns an empty string for no rule.
+func (s *StorageDetails) UnmarshalJSON(data []byte) error {
+	type storageDetails StorageDetails
+	v := struct {
+		*storageDetails
+		BackupRule json.RawMessage `json:"backup_rule"`
+	}{storageDetails: (*storageDetails)(s)}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	s.BackupRule = nil
+	switch string(v.BackupRule) {
+	case "", "null", `""`:
+		return nil
+	}
+	s.BackupRule = new(BackupRule)
+	return json.Unmarshal(v.BackupRule, s.BackupRule)
+}
+
+// StorageDetailsResponse represents the response from storage details API methods
+type StorageDetailsResponse struct {
+	Storage *StorageDetails `json:"storage"`
+}
+
+// ListStorages returns the list of storages, optionally filtered by storageType.
+// An empty storageType returns all storages available to the account.
+// https://developers.upcloud.com/1.3/9-storages/#list-storages
+func (s *StorageService) ListStorages(ctx context.Context, storageType string) (*StorageList, *http.Response, error) {
+	u := "storage"
+	if storageType != "" {
+		u = fmt.Sprintf("storage/%v", storageType)
+	}
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	storageList := new(StorageList)
+	resp, err := s.client.Do(ctx, req, &StorageListResponse{StorageList: storageList})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return storageList, resp, nil
+}
+
+// GetStorageDetails returns the full description of the storage with the given uuid.
+// https://developers.upcloud.com/1.3/9-storages/#get-storage-details
+func (s *StorageService) GetStorageDetails(ctx context.Context, uuid string) (*StorageDetails, *http.Response, error) {
+	u := fmt.Sprintf("storage/%v", uuid)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	storage := new(StorageDetails)
+	resp, err := s.client.Do(ctx, req, &StorageDetailsResponse{Storage: storage})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return storage, resp, nil
+}
+
+// CreateStorageRequest represents the properties of a new storage
+type CreateStorageRequest struct {
+	Size       int         `json:"size"` //GiB
+	Tier       string      `json:"tier,omitempty"`
+	Title      string      `json:"title"`
+	Zone       string      `json:"zone"`
+	BackupRule *BackupRule `json:"backup_rule,omitempty"`
+}
+
+type createStorageRequestWrapper struct {
+	Storage *CreateStorageRequest `json:"storage"`
+}
+
+// CreateStorage creates a new storage with the properties defined in r.
+// https://developers.upcloud.com/1.3/9-storages/#create-storage
+func (s *StorageService) CreateStorage(ctx context.Context, r *CreateStorageRequest) (*StorageDetails, *http.Response, error) {
+	if r.BackupRule != nil {
+		if err := r.BackupRule.Validate(); err != nil {
+			return nil, nil, err
+		}
+	}
+	req, err := s.client.NewRequest("POST", "storage", &createStorageRequestWrapper{Storage: r})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	storage := new(StorageDetails)
+	resp, err := s.client.Do(ctx, req, &StorageDetailsResponse{Storage: storage})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return storage, resp, nil
+}
+
+// ModifyStorageRequest represents the properties of a storage to modify, empty values are left unchanged.
+// Set RemoveBackupRule instead of BackupRule to remove the existing backup rule.
+type ModifyStorageRequest struct {
+	Size             int         `json:"size,omitempty"` //GiB, can only grow
+	Title            string      `json:"title,omitempty"`
+	BackupRule       *BackupRule `json:"backup_rule,omitempty"`
+	RemoveBackupRule bool        `json:"-"`
+}
+
+// MarshalJSON encodes the request, sending an empty backup rule when RemoveBackupRule is set.
+func (r *ModifyStorageRequest) MarshalJSON() ([]byte, error) {
+	type modifyStorageRequest ModifyStorageRequest
+	if !r.RemoveBackupRule {
+		return json.Marshal((*modifyStorageRequest)(r))
+	}
+	return json.Marshal(&struct {
+		*modifyStorageRequest
+		BackupRule string `json:"backup_rule"`
+	}{modifyStorageRequest: (*modifyStorageRequest)(r)})
+}
+
+type modifyStorageRequestWrapper struct {
+	Storage *ModifyStorageRequest `json:"storage"`
+}
+
+// ModifyStorage modifies the storage with the given uuid using the properties defined in r.
+// https://developers.upcloud.com/1.3/9-storages/#modify-storage
+func (s *StorageService) ModifyStorage(ctx context.Context, uuid string, r *ModifyStorageRequest) (*StorageDetails, *http.Response, error) {
+	if r.RemoveBackupRule && r.BackupRule != nil {
+		return nil, nil, errors.New("backup rule cannot be both set and removed")
+	}
+	if r.BackupRule != nil {
+		if err := r.BackupRule.Validate(); err != nil {
+			return nil, nil, err
+		}
+	}
+	u := fmt.Sprintf("storage/%v", uuid)
+	req, err := s.client.NewRequest("PUT", u, &modifyStorageRequestWrapper{Storage: r})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	storage := new(StorageDetails)
+	resp, err := s.client.Do(ctx, req, &StorageDetailsResponse{Storage: storage})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return storage, resp, nil
+}
+
+// DeleteStorage deletes the storage with the given uuid, the storage must not be attached to a server.
+// backups can be keep/keep_latest/delete, an empty value keeps the API default.
+// https://developers.upcloud.com/1.3/9-storages/#delete-storage
+func (s *StorageService) DeleteStorage(ctx context.Context, uuid string, backups string) (*http.Response, error) {
+	u := fmt.Sprintf("storage/%v", uuid)
+	if backups != "" {
+		q := url.Values{}
+		q.Set("backups", backups)
+		u += "?" + q.Encode()
+	}
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// CloneStorageRequest represents the properties of a cloned storage
+type CloneStorageRequest struct {
+	Zone  string `json:"zone"`
+	Tier  string `json:"tier,omitempty"`
+	Title string `json:"title"`
+}
+
+type cloneStorageRequestWrapper struct {
+	Storage *CloneStorageRequest `json:"storage"`
+}
+
+// CloneStorage creates an exact copy of the storage with the given uuid.
+// https://developers.upcloud.com/1.3/9-storages/#clone-storage
+func (s *StorageService) CloneStorage(ctx context.Context, uuid string, r *CloneStorageRequest) (*StorageDetails, *http.Response, error) {
+	u := fmt.Sprintf("storage/%v/clone", uuid)
+	req, err := s.client.NewRequest("POST", u, &cloneStorageRequestWrapper{Storage: r})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	storage := new(StorageDetails)
+	resp, err := s.client.Do(ctx, req, &StorageDetailsResponse{Storage: storage})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return storage, resp, nil
+}
+
+// TemplatizeStorageRequest represents the properties of a new template
+type TemplatizeStorageRequest struct {
+	Title string `json:"title"`
+}
+
+type templatizeStorageRequestWrapper struct {
+	Storage *TemplatizeStorageRequest `json:"storage"`
+}
+
+// TemplatizeStorage creates a private template from the storage with the given uuid.
+// https://developers.upcloud.com/1.3/9-storages/#templatize-storage
+func (s *StorageService) TemplatizeStorage(ctx context.Context, uuid string, r *TemplatizeStorageRequest) (*StorageDetails, *http.Response, error) {
+	u := fmt.Sprintf("storage/%v/templatize", uuid)
+	req, err := s.client.NewRequest("POST", u, &templatizeStorageRequestWrapper{Storage: r})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	storage := new(StorageDetails)
+	resp, err := s.client.Do(ctx, req, &StorageDetailsResponse{Storage: storage})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return storage, resp, nil
+}
+
+// AttachStorageRequest represents how a storage is attached to a server
+type AttachStorageRequest struct {
+	Type     string `json:"type,omitempty"`      //disk/cdrom
+	Address  string `json:"address,omitempty"`   //ide/scsi/virtio, optionally with bus number
+	Storage  string `json:"storage,omitempty"`   //Storage UUID, may be empty for cdrom
+	BootDisk string `json:"boot_disk,omitempty"` //0/1
+}
+
+type storageDeviceRequestWrapper struct {
+	StorageDevice interface{} `json:"storage_device"`
+}
+
+// AttachStorage attaches a storage to the server with the given uuid, the server must be stopped.
+// https://developers.upcloud.com/1.3/9-storages/#attach-storage
+func (s *StorageService) AttachStorage(ctx context.Context, serverUUID string, r *AttachStorageRequest) (*ServerDetails, *http.Response, error) {
+	u := fmt.Sprintf("server/%v/storage/attach", serverUUID)
+	return s.serverStorageAction(ctx, u, &storageDeviceRequestWrapper{StorageDevice: r})
+}
+
+// DetachStorage detaches the storage at address from the server with the given uuid, the server must be stopped.
+// https://developers.upcloud.com/1.3/9-storages/#detach-storage
+func (s *StorageService) DetachStorage(ctx context.Context, serverUUID string, address string) (*ServerDetails, *http.Response, error) {
+	u := fmt.Sprintf("server/%v/storage/detach", serverUUID)
+	body := &storageDeviceRequestWrapper{StorageDevice: struct {
+		Address string `json:"address"`
+	}{address}}
+	return s.serverStorageAction(ctx, u, body)
+}
+
+// LoadCDROM loads the storage with the given uuid as media into the CD-ROM device of a server.
+// https://developers.upcloud.com/1.3/9-storages/#load-cd-rom
+func (s *StorageService) LoadCDROM(ctx context.Context, serverUUID string, storageUUID string) (*ServerDetails, *http.Response, error) {
+	u := fmt.Sprintf("server/%v/cdrom/load", serverUUID)
+	body := &storageDeviceRequestWrapper{StorageDevice: struct {
+		Storage string `json:"storage"`
+	}{storageUUID}}
+	return s.serverStorageAction(ctx, u, body)
+}
+
+// EjectCDROM ejects the media from the CD-ROM device of a server.
+// https://developers.upcloud.com/1.3/9-storages/#eject-cd-rom
+func (s *StorageService) EjectCDROM(ctx context.Context, serverUUID string) (*ServerDetails, *http.Response, error) {
+	u := fmt.Sprintf("server/%v/cdrom/eject", serverUUID)
+	return s.serverStorageAction(ctx, u, nil)
+}
+
+// serverStorageAction posts body to a server storage endpoint and returns the resulting server details
+func (s *StorageService) serverStorageAction(ctx context.Context, u string, body interface{}) (*ServerDetails, *http.Response, error) {
+	req, err := s.client.NewRequest("POST", u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	server := new(ServerDetails)
+	resp, err := s.client.Do(ctx, req, &ServerDetailsResponse{Server: server})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return server, resp, nil
+}
@@ -0,0 +1,33 @@
+package upcloud
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestStorageDetailsBackupRuleDecoding(t *testing.T) {
+	tests := []struct {
+		data string
+		want *BackupRule
+	}{
+		{`{"uuid":"a","backup_rule":""}`, nil},
+		{`{"uuid":"a","backup_rule":null}`, nil},
+		{`{"uuid":"a"}`, nil},
+		{`{"uuid":"a","backup_rule":{"interval":"daily","time":"0430","retention":"7"}}`, &BackupRule{Interval: BackupIntervalDaily, Time: "0430", Retention: 7}},
+	}
+	for _, tt := range tests {
+		var s StorageDetails
+		if err := json.Unmarshal([]byte(tt.data), &s); err != nil {
+			t.Fatalf("Unmarshal(%s) returned error: %v", tt.data, err)
+		}
+		if s.UUID != "a" {
+			t.Errorf("Unmarshal(%s) UUID = %q, want %q", tt.data, s.UUID, "a")
+		}
+		switch {
+		case tt.want == nil && s.BackupRule != nil:
+			t.Errorf("Unmarshal(%s) BackupRule = %+v, want nil", tt.data, s.BackupRule)
+		case tt.want != nil && (s.BackupRule == nil || *s.BackupRule != *tt.want):
+			t.Errorf("Unmarshal(%s) BackupRule = %+v, want %+v", tt.data, s.BackupRule, tt.want)
+		}
+	}
+}
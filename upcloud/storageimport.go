@@ -0,0 +1,197 @@
+package upcloud
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Storage import sources
+const (
+	StorageImportSourceHTTPImport   = "http_import"
+	StorageImportSourceDirectUpload = "direct_upload"
+)
+
+// Storage import states
+const (
+	StorageImportStatePrepared   = "prepared"
+	StorageImportStatePending    = "pending"
+	StorageImportStateImporting  = "importing"
+	StorageImportStateCompleted  = "completed"
+	StorageImportStateFailed     = "failed"
+	StorageImportStateCancelling = "cancelling"
+	StorageImportStateCancelled  = "cancelled"
+)
+
+// StorageImport represents an import of data into a storage
+type StorageImport struct {
+	ClientContentLength int64  `json:"client_content_length"`
+	ClientContentType   string `json:"client_content_type"`
+	Completed           string `json:"completed"`
+	Created             string `json:"created"`
+	DirectUploadURL     string `json:"direct_upload_url"`
+	ErrorCode           string `json:"error_code"`
+	ErrorMessage        string `json:"error_message"`
+	MD5Sum              string `json:"md5sum"`
+	ReadBytes           int64  `json:"read_bytes"`
+	SHA256Sum           string `json:"sha256sum"`
+	Source              string `json:"source"` //http_import/direct_upload
+	SourceLocation      string `json:"source_location"`
+	State               string `json:"state"` //prepared/pending/importing/completed/failed/cancelling/cancelled
+	UUID                string `json:"uuid"`
+	WrittenBytes        int64  `json:"written_bytes"`
+}
+
+// StorageImportResponse represents the response from storage import API methods
+type StorageImportResponse struct {
+	StorageImport *StorageImport `json:"storage_import"`
+}
+
+// CreateStorageImportRequest represents the source of a new storage import.
+// SourceLocation is the URL to import from and is only used with http_import.
+type CreateStorageImportRequest struct {
+	Source         string `json:"source"` //http_import/direct_upload
+	SourceLocation string `json:"source_location,omitempty"`
+}
+
+type createStorageImportRequestWrapper struct {
+	StorageImport *CreateStorageImportRequest `json:"storage_import"`
+}
+
+// CreateStorageImport starts an import into the storage with the given uuid.
+// For direct_upload the returned DirectUploadURL is passed to UploadStorageImport.
+// https://developers.upcloud.com/1.3/9-storages/#create-storage-import
+func (s *StorageService) CreateStorageImport(ctx context.Context, uuid string, r *CreateStorageImportRequest) (*StorageImport, *http.Response, error) {
+	u := fmt.Sprintf("storage/%v/import", uuid)
+	req, err := s.client.NewRequest("POST", u, &createStorageImportRequestWrapper{StorageImport: r})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	storageImport := new(StorageImport)
+	resp, err := s.client.Do(ctx, req, &StorageImportResponse{StorageImport: storageImport})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return storageImport, resp, nil
+}
+
+// GetStorageImportDetails returns the current import of the storage with the given uuid.
+// https://developers.upcloud.com/1.3/9-storages/#get-storage-import-details
+func (s *StorageService) GetStorageImportDetails(ctx context.Context, uuid string) (*StorageImport, *http.Response, error) {
+	u := fmt.Sprintf("storage/%v/import", uuid)
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	storageImport := new(StorageImport)
+	resp, err := s.client.Do(ctx, req, &StorageImportResponse{StorageImport: storageImport})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return storageImport, resp, nil
+}
+
+// CancelStorageImport cancels the ongoing import of the storage with the given uuid.
+// https://developers.upcloud.com/1.3/9-storages/#cancel-storage-import
+func (s *StorageService) CancelStorageImport(ctx context.Context, uuid string) (*StorageImport, *http.Response, error) {
+	u := fmt.Sprintf("storage/%v/import/cancel", uuid)
+	req, err := s.client.NewRequest("POST", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	storageImport := new(StorageImport)
+	resp, err := s.client.Do(ctx, req, &StorageImportResponse{StorageImport: storageImport})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return storageImport, resp, nil
+}
+
+// StorageImportUploadResult represents the response from a direct upload
+type StorageImportUploadResult struct {
+	WrittenBytes int64  `json:"written_bytes"`
+	MD5Sum       string `json:"md5sum"`
+	SHA256Sum    string `json:"sha256sum"`
+}
+
+// UploadProgressFunc is called as an upload proceeds with the number of bytes sent so far and the total size.
+type UploadProgressFunc func(written, total int64)
+
+// progressReader reports the bytes read through it to fn
+type progressReader struct {
+	r       io.Reader
+	written int64
+	total   int64
+	fn      UploadProgressFunc
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	if n > 0 {
+		p.written += int64(n)
+		p.fn(p.written, p.total)
+	}
+	return n, err
+}
+
+// UploadStorageImport streams size bytes from r to uploadURL, the DirectUploadURL of a direct_upload import.
+// mediaType describes the image, e.g. application/octet-stream or application/x-xz, and progress may be nil.
+// Uploads to a host other than BaseURL are sent without the BasicAuthTransport credentials.
+// https://developers.upcloud.com/1.3/9-storages/#direct-upload
+func (s *StorageService) UploadStorageImport(ctx context.Context, uploadURL string, r io.Reader, size int64, mediaType string, progress UploadProgressFunc) (*StorageImportUploadResult, *http.Response, error) {
+	if progress != nil {
+		r = &progressReader{r: r, total: size, fn: progress}
+	}
+	req, err := s.client.NewUploadRequest("PUT", uploadURL, r, size, mediaType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The upload session URL authorises the upload, never send the API credentials to another host
+	if req.URL.Host != s.client.BaseURL.Host {
+		ctx = withoutCredentials(ctx)
+	}
+
+	result := new(StorageImportUploadResult)
+	resp, err := s.client.Do(ctx, req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result, resp, nil
+}
+
+// WaitForStorageImport polls the import of the storage with the given uuid until it completes or ctx expires.
+// An error is returned if the import fails or is cancelled.
+func (s *StorageService) WaitForStorageImport(ctx context.Context, uuid string) (*StorageImport, *http.Response, error) {
+	var storageImport *StorageImport
+	var resp *http.Response
+	err := poll(ctx, func() (bool, error) {
+		var err error
+		storageImport, resp, err = s.GetStorageImportDetails(ctx, uuid)
+		if err != nil {
+			return false, err
+		}
+		switch storageImport.State {
+		case StorageImportStateCompleted:
+			return true, nil
+		case StorageImportStateFailed:
+			return false, fmt.Errorf("storage %v import failed: %v %v", uuid, storageImport.ErrorCode, storageImport.ErrorMessage)
+		case StorageImportStateCancelled:
+			return false, fmt.Errorf("storage %v import was cancelled", uuid)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return storageImport, resp, nil
+}
@@ -0,0 +1,56 @@
+package upcloud
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestUploadStorageImportOmitsCredentials(t *testing.T) {
+	var uploadAuth, uploadBody string
+	upload := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		uploadAuth = r.Header.Get("Authorization")
+		b, _ := ioutil.ReadAll(r.Body)
+		uploadBody = string(b)
+		w.Write([]byte(`{"written_bytes":4}`))
+	}))
+	defer upload.Close()
+
+	var apiAuth string
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		apiAuth = r.Header.Get("Authorization")
+		w.Write([]byte(`{"storage_import":{"state":"prepared","direct_upload_url":"` + upload.URL + `/uploader/session/x"}}`))
+	}))
+	defer api.Close()
+
+	c, err := NewClientWithOptions(WithBaseURL(api.URL), WithCredentials("user", "secret"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	imp, _, err := c.Storage.CreateStorageImport(ctx, "uuid", &CreateStorageImportRequest{Source: StorageImportSourceDirectUpload})
+	if err != nil {
+		t.Fatalf("CreateStorageImport returned error: %v", err)
+	}
+	if apiAuth == "" {
+		t.Error("API request was sent without credentials")
+	}
+
+	var progress int64
+	result, _, err := c.Storage.UploadStorageImport(ctx, imp.DirectUploadURL, strings.NewReader("data"), 4, "", func(written, total int64) {
+		progress = written
+	})
+	if err != nil {
+		t.Fatalf("UploadStorageImport returned error: %v", err)
+	}
+	if uploadAuth != "" {
+		t.Errorf("upload host received Authorization header %q, want none", uploadAuth)
+	}
+	if uploadBody != "data" || result.WrittenBytes != 4 || progress != 4 {
+		t.Errorf("upload body %q, written %d, progress %d, want %q, 4, 4", uploadBody, result.WrittenBytes, progress, "data")
+	}
+}
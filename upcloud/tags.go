@@ -0,0 +1,143 @@
+package upcloud
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// TagsService handles communication with the tag related methods of the UpCloud API
+// https://developers.upcloud.com/1.3/12-tags/
+type TagsService service
+
+// TagServerList represents the list of server UUIDs a tag is assigned to
+type TagServerList struct {
+	Servers []string `json:"server"`
+}
+
+// Tag represents a tag that can be assigned to servers
+type Tag struct {
+	Name        string         `json:"name,omitempty"` //Alphanumeric and underscore, implied by URL on modify
+	Description string         `json:"description,omitempty"`
+	Servers     *TagServerList `json:"servers,omitempty"`
+}
+
+// TagList represents the list of tags
+type TagList struct {
+	Tags []Tag `json:"tag"`
+}
+
+// TagListResponse represents the response from the ListTags API call
+type TagListResponse struct {
+	TagList *TagList `json:"tags"`
+}
+
+// TagDetails represents the request/response wrapper for a single tag
+type TagDetails struct {
+	Tag *Tag `json:"tag"`
+}
+
+// ListTags returns the tags on the account along with the servers they are assigned to.
+// https://developers.upcloud.com/1.3/12-tags/#list-existing-tags
+func (s *TagsService) ListTags(ctx context.Context) (*TagList, *http.Response, error) {
+	req, err := s.client.NewRequest("GET", "tag", nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tagList := new(TagList)
+	resp, err := s.client.Do(ctx, req, &TagListResponse{TagList: tagList})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return tagList, resp, nil
+}
+
+// CreateTag creates a new tag, optionally assigning it to the servers listed in tag.
+// https://developers.upcloud.com/1.3/12-tags/#create-a-new-tag
+func (s *TagsService) CreateTag(ctx context.Context, tag *Tag) (*Tag, *http.Response, error) {
+	req, err := s.client.NewRequest("POST", "tag", &TagDetails{Tag: tag})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	created := new(Tag)
+	resp, err := s.client.Do(ctx, req, &TagDetails{Tag: created})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return created, resp, nil
+}
+
+// ModifyTag modifies the tag with the given name, setting tag.Name renames it.
+// https://developers.upcloud.com/1.3/12-tags/#modify-existing-tag
+func (s *TagsService) ModifyTag(ctx context.Context, name string, tag *Tag) (*Tag, *http.Response, error) {
+	u := fmt.Sprintf("tag/%v", url.PathEscape(name))
+	req, err := s.client.NewRequest("PUT", u, &TagDetails{Tag: tag})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	modified := new(Tag)
+	resp, err := s.client.Do(ctx, req, &TagDetails{Tag: modified})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return modified, resp, nil
+}
+
+// DeleteTag deletes the tag with the given name, removing it from all servers.
+// https://developers.upcloud.com/1.3/12-tags/#delete-tag
+func (s *TagsService) DeleteTag(ctx context.Context, name string) (*http.Response, error) {
+	u := fmt.Sprintf("tag/%v", url.PathEscape(name))
+	req, err := s.client.NewRequest("DELETE", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(ctx, req, nil)
+}
+
+// TagServer assigns the existing tags to the server with the given uuid.
+// https://developers.upcloud.com/1.3/12-tags/#assign-tag-to-a-server
+func (s *TagsService) TagServer(ctx context.Context, serverUUID string, tags ...string) (*ServerDetails, *http.Response, error) {
+	return s.serverTagAction(ctx, serverUUID, "tag", tags)
+}
+
+// UntagServer removes the tags from the server with the given uuid.
+// https://developers.upcloud.com/1.3/12-tags/#remove-tag-from-server
+func (s *TagsService) UntagServer(ctx context.Context, serverUUID string, tags ...string) (*ServerDetails, *http.Response, error) {
+	return s.serverTagAction(ctx, serverUUID, "untag", tags)
+}
+
+// serverTagAction posts the tags to a server tag endpoint and returns the resulting server details
+func (s *TagsService) serverTagAction(ctx context.Context, serverUUID, action string, tags []string) (*ServerDetails, *http.Response, error) {
+	if len(tags) == 0 {
+		return nil, nil, fmt.Errorf("%v requires at least one tag", action)
+	}
+	escaped := make([]string, len(tags))
+	for i, tag := range tags {
+		if tag == "" {
+			return nil, nil, fmt.Errorf("%v requires non-empty tag names", action)
+		}
+		escaped[i] = url.PathEscape(tag)
+	}
+	u := fmt.Sprintf("server/%v/%v/%v", serverUUID, action, strings.Join(escaped, ","))
+	req, err := s.client.NewRequest("POST", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	server := new(ServerDetails)
+	resp, err := s.client.Do(ctx, req, &ServerDetailsResponse{Server: server})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return server, resp, nil
+}
@@ -26,13 +26,36 @@ type Client struct {
 
 	UserAgent string
 
+	// RetryPolicy controls retries of failed requests, nil disables retries
+	RetryPolicy *RetryPolicy
+
+	// Limiter, if set, delays each attempt to send a request
+	Limiter Limiter
+
+	inFlight chan struct{}
+
 	common service
 
-	Accounts  *AccountService
-	Plans     *PlansService
-	Pricing   *PricingService
-	Timezones *TimezonesService
-	Zones     *ZonesService
+	Accounts      *AccountService
+	Databases     *DatabasesService
+	Firewall      *FirewallService
+	Gateways      *GatewaysService
+	Hosts         *HostsService
+	IPAddresses   *IPAddressService
+	Kubernetes    *KubernetesService
+	LoadBalancers *LoadBalancerService
+	Networks      *NetworksService
+	ObjectStorage *ObjectStorageService
+	Permissions   *PermissionsService
+	Plans         *PlansService
+	Pricing       *PricingService
+	Routers       *RoutersService
+	Servers       *ServersService
+	ServerGroups  *ServerGroupsService
+	Storage       *StorageService
+	Tags          *TagsService
+	Timezones     *TimezonesService
+	Zones         *ZonesService
 }
 
 type service struct {
@@ -46,14 +69,30 @@ func NewClient(httpClient *http.Client) *Client {
 		httpClient = &http.Client{}
 	}
 
+	// defaultBaseURL is constant and always parses, use NewClientWithOptions to set a different one
 	baseURL, _ := url.Parse(defaultBaseURL)
 
 	c := &Client{client: httpClient, BaseURL: baseURL, UserAgent: userAgent}
 	c.common.client = c
 
 	c.Accounts = (*AccountService)(&c.common)
+	c.Databases = (*DatabasesService)(&c.common)
+	c.Firewall = (*FirewallService)(&c.common)
+	c.Gateways = (*GatewaysService)(&c.common)
+	c.Hosts = (*HostsService)(&c.common)
+	c.IPAddresses = (*IPAddressService)(&c.common)
+	c.Kubernetes = (*KubernetesService)(&c.common)
+	c.LoadBalancers = (*LoadBalancerService)(&c.common)
+	c.Networks = (*NetworksService)(&c.common)
+	c.ObjectStorage = (*ObjectStorageService)(&c.common)
+	c.Permissions = (*PermissionsService)(&c.common)
 	c.Plans = (*PlansService)(&c.common)
 	c.Pricing = (*PricingService)(&c.common)
+	c.Routers = (*RoutersService)(&c.common)
+	c.Servers = (*ServersService)(&c.common)
+	c.ServerGroups = (*ServerGroupsService)(&c.common)
+	c.Storage = (*StorageService)(&c.common)
+	c.Tags = (*TagsService)(&c.common)
 	c.Timezones = (*TimezonesService)(&c.common)
 	c.Zones = (*ZonesService)(&c.common)
 
@@ -96,72 +135,155 @@ func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Requ
 	return req, nil
 }
 
+// NewUploadRequest creates a new request that sends the contents of reader as the raw body.
+// urlStr may be relative to BaseURL or absolute, size is sent as the Content-Length
+// and mediaType as the Content-Type, defaulting to application/octet-stream.
+func (c *Client) NewUploadRequest(method, urlStr string, reader io.Reader, size int64, mediaType string) (*http.Request, error) {
+	if !strings.HasSuffix(c.BaseURL.Path, "/") {
+		return nil, fmt.Errorf("BaseURL must have a trailing slash, but %q does not", c.BaseURL)
+	}
+	u, err := c.BaseURL.Parse(urlStr)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(method, u.String(), reader)
+	if err != nil {
+		return nil, err
+	}
+	req.ContentLength = size
+
+	if mediaType == "" {
+		mediaType = "application/octet-stream"
+	}
+	req.Header.Set("Content-Type", mediaType)
+	req.Header.Set("Accept", "application/json")
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+	return req, nil
+}
+
 // ErrorResponse reports an error caused by the API request.
+// It is returned for every non-2xx response, APIError is nil if the body had no UpCloud error object.
+// It matches the sentinel errors in errors.go with errors.Is.
 type ErrorResponse struct {
-	Response *http.Response
-	APIError *struct {
-		Message string `json:"error_message"`
-		Code    string `json:"error_code"`
-	} `json:"error"`
+	Response  *http.Response
+	APIError  *APIError `json:"error"`
+	RequestID string    `json:"-"` // From the X-Request-Id or X-Correlation-Id response header
+	Body      string    `json:"-"` // Raw response body, truncated to maxErrorBodyLength bytes
 }
 
 func (r *ErrorResponse) Error() string {
-	return fmt.Sprintf("%v %v: %d %v - %v",
-		r.Response.Request.Method, r.Response.Request.URL,
-		r.Response.StatusCode, r.APIError.Code, r.APIError.Message)
+	var method, u string
+	var status int
+	if r.Response != nil {
+		status = r.Response.StatusCode
+		if r.Response.Request != nil {
+			method, u = r.Response.Request.Method, r.Response.Request.URL.String()
+		}
+	}
+	msg := fmt.Sprintf("%v %v: %d", method, u, status)
+	switch {
+	case r.APIError != nil:
+		msg += fmt.Sprintf(" %v - %v", r.APIError.Code, r.APIError.Message)
+	case r.Body != "":
+		msg += " " + r.Body
+	case r.Response != nil:
+		msg += " " + http.StatusText(status)
+	}
+	if r.RequestID != "" {
+		msg += fmt.Sprintf(" (request id %v)", r.RequestID)
+	}
+	return msg
 }
 
 // Do sends API request and returns http.Response
+// Failed attempts are retried according to RetryPolicy, if set.
+// Each attempt waits for the Limiter and the SetMaxInFlight limit.
 func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*http.Response, error) {
 	if ctx == nil {
 		return nil, errors.New("context must be non-nil")
 	}
 	req = req.WithContext(ctx)
 
-	resp, err := c.client.Do(req)
-	if err != nil {
-		// If we got an error, and the context has been canceled,
-		// the context's error is probably more useful.
-		select {
-		case <-ctx.Done():
-			return nil, ctx.Err()
-		default:
-			return nil, err
+	for attempt := 1; ; attempt++ {
+		if attempt > 1 {
+			if err := rewind(req); err != nil {
+				return nil, err
+			}
 		}
-	}
 
-	defer resp.Body.Close()
+		release, err := c.acquire(ctx)
+		if err != nil {
+			return nil, err
+		}
 
-	data, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return resp, err
-	}
+		resp, err := c.client.Do(req)
+		if err != nil {
+			release()
+			// If we got an error, and the context has been canceled,
+			// the context's error is probably more useful.
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			default:
+			}
+			if !c.RetryPolicy.retryable(req, attempt, nil, nil) {
+				return nil, err
+			}
+			d, _ := c.RetryPolicy.backoff(attempt, nil)
+			if err := sleep(ctx, d); err != nil {
+				return nil, err
+			}
+			continue
+		}
 
-	//fmt.Println(string(data))
+		data, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		release()
+		if err != nil {
+			return resp, err
+		}
 
-	// On success, decode in to v if given
-	if c := resp.StatusCode; 200 <= c && c <= 299 {
+		//fmt.Println(string(data))
 
-		if v != nil && data != nil {
-			decErr := json.Unmarshal(data, v)
-			if decErr == io.EOF {
-				decErr = nil
+		// On success, decode in to v if given
+		if c := resp.StatusCode; 200 <= c && c <= 299 {
+			if v != nil && len(data) > 0 {
+				err = json.Unmarshal(data, v)
 			}
-			err = decErr
+			return resp, err
 		}
-		return resp, err
-	}
 
-	// On error, decode into ErrorResponse
-	errResp := &ErrorResponse{Response: resp}
-	if data != nil {
-		err = json.Unmarshal(data, errResp)
-		if err != nil {
+		errResp := newErrorResponse(resp, data)
+		if !c.RetryPolicy.retryable(req, attempt, resp, errResp) {
+			return resp, errResp
+		}
+		d, ok := c.RetryPolicy.backoff(attempt, resp)
+		if !ok {
+			return resp, errResp
+		}
+		if err := sleep(ctx, d); err != nil {
 			return resp, err
 		}
 	}
-	return resp, errResp
+}
+
+type contextKey string
+
+// skipCredentialsKey marks a request context whose request must not carry API credentials
+const skipCredentialsKey contextKey = "skip-credentials"
 
+// withoutCredentials returns a copy of ctx telling BasicAuthTransport not to authenticate the request,
+// used for requests to hosts other than the API such as storage upload sessions.
+func withoutCredentials(ctx context.Context) context.Context {
+	return context.WithValue(ctx, skipCredentialsKey, true)
+}
+
+func skipCredentials(ctx context.Context) bool {
+	skip, _ := ctx.Value(skipCredentialsKey).(bool)
+	return skip
 }
 
 // BasicAuthTransport is an http.RoundTripper that authenticates all requests
@@ -174,6 +296,7 @@ type BasicAuthTransport struct {
 }
 
 // RoundTrip implements the RoundTripper interface.
+// Requests whose context was marked by withoutCredentials are sent without an Authorization header.
 func (t *BasicAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 
 	req2 := new(http.Request)
@@ -184,7 +307,11 @@ func (t *BasicAuthTransport) RoundTrip(req *http.Request) (*http.Response, error
 		req2.Header[k] = append([]string(nil), s...)
 	}
 
-	req2.SetBasicAuth(t.Username, t.Password)
+	if skipCredentials(req.Context()) {
+		req2.Header.Del("Authorization")
+	} else {
+		req2.SetBasicAuth(t.Username, t.Password)
+	}
 
 	return t.transport().RoundTrip(req2)
 }
@@ -0,0 +1,36 @@
+package upcloud
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	pollIntervalMin = time.Second
+	pollIntervalMax = 16 * time.Second
+)
+
+// poll calls fn until it reports done, returns an error or ctx expires.
+// The interval between calls doubles from pollIntervalMin up to pollIntervalMax.
+func poll(ctx context.Context, fn func() (bool, error)) error {
+	interval := pollIntervalMin
+	for {
+		done, err := fn()
+		if err != nil || done {
+			return err
+		}
+
+		t := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			t.Stop()
+			return ctx.Err()
+		case <-t.C:
+		}
+
+		interval *= 2
+		if interval > pollIntervalMax {
+			interval = pollIntervalMax
+		}
+	}
+}